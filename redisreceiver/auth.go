@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/go-redis/redis/v7"
+)
+
+// authErrorPrefixes are the prefixes of the replies of servers rejecting a
+// client that did not authenticate or did so with the wrong credentials.
+var authErrorPrefixes = []string{
+	"NOAUTH",                     // no credentials were given
+	"WRONGPASS",                  // ACL users, Redis 6.0 and later
+	"ERR invalid password",       // requirepass, before Redis 6.0
+	"ERR invalid username",       // AUTH with a username, before Redis 6.0
+	"ERR AUTH <password> called", // a password was given to a server without any
+	"ERR Client sent AUTH",       // same, before Redis 6.0
+}
+
+// authError is returned when the server rejects the credentials, so that it
+// can be told apart from other scrape failures.
+type authError struct {
+	endpoint string
+	err      error
+}
+
+func (e *authError) Error() string {
+	return fmt.Sprintf("failed to authenticate to %s, check username, password and password_file: %v", e.endpoint, e.err)
+}
+
+func (e *authError) Unwrap() error {
+	return e.err
+}
+
+// isAuthError reports whether err is the reply of a server rejecting the
+// credentials of the client.
+func isAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	for _, prefix := range authErrorPrefixes {
+		if strings.HasPrefix(msg, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// readPasswordFile returns the password held by the file, without the
+// trailing newline most editors and secret stores add.
+func readPasswordFile(path string) (string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password_file: %w", err)
+	}
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
+
+// authenticateWithPasswordFile returns an OnConnect hook authenticating every
+// new connection with the password currently in the file, so that a rotated
+// password is picked up without restarting the collector.
+func authenticateWithPasswordFile(username string, path string) func(*redis.Conn) error {
+	return func(conn *redis.Conn) error {
+		password, err := readPasswordFile(path)
+		if err != nil {
+			return err
+		}
+		if username != "" {
+			return conn.AuthACL(username, password).Err()
+		}
+		return conn.Auth(password).Err()
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+func TestIsAuthError(t *testing.T) {
+	for _, msg := range []string{
+		"NOAUTH Authentication required.",
+		"WRONGPASS invalid username-password pair or user is disabled.",
+		"ERR invalid password",
+		"ERR AUTH <password> called without any password configured for the default user. Are you sure your configuration is correct?",
+		"ERR Client sent AUTH, but no password is set",
+	} {
+		assert.True(t, isAuthError(errors.New(msg)), msg)
+	}
+	assert.False(t, isAuthError(errors.New("dial tcp 127.0.0.1:6379: connect: connection refused")))
+	assert.False(t, isAuthError(nil))
+}
+
+func TestReadPasswordFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, ioutil.WriteFile(path, []byte("s3cret\n"), 0600))
+	password, err := readPasswordFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", password)
+
+	_, err = readPasswordFile(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+	// the file is read before the connection is used
+	assert.Error(t, authenticateWithPasswordFile("collector", filepath.Join(t.TempDir(), "missing"))(nil))
+}
+
+func TestEndpointOptionsCredentials(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Username = "collector"
+	cfg.Password = "s3cret"
+	opts, err := newRedisOptions(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "collector", opts.Username)
+	assert.Equal(t, "s3cret", opts.Password)
+	assert.Nil(t, opts.OnConnect)
+
+	cfg.Password = ""
+	cfg.PasswordFile = filepath.Join(t.TempDir(), "password")
+	opts, err = newRedisOptions(cfg)
+	require.NoError(t, err)
+	assert.Empty(t, opts.Password)
+	assert.NotNil(t, opts.OnConnect)
+
+	// a password set for an endpoint replaces the file
+	opts, err = newEndpointOptions(cfg, EndpointSettings{
+		NetAddr:  confignet.NetAddr{Endpoint: "redis-a:6379"},
+		Password: "other",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "other", opts.Password)
+	assert.Nil(t, opts.OnConnect)
+}
+
+type wrongPassClient struct {
+	fakeClient
+}
+
+func (wrongPassClient) retrieveInfo([]string) (string, error) {
+	return "", errors.New("WRONGPASS invalid username-password pair or user is disabled.")
+}
+
+func TestRedisScraperAuthError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:6379"
+	rs := newNodeScraper(wrongPassClient{}, cfg.Endpoint, componenttest.NewNopReceiverCreateSettings(), cfg)
+	_, err := rs.scrapeInfo()
+	require.Error(t, err)
+
+	var authErr *authError
+	require.True(t, errors.As(err, &authErr))
+	assert.Equal(t, "localhost:6379", authErr.endpoint)
+
+	// The scrape fails partially so that redis.up is still emitted.
+	_, err = rs.Scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Contains(t, err.Error(), "failed to authenticate to localhost:6379")
+	assert.Contains(t, err.Error(), "WRONGPASS")
+}
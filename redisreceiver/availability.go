@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"strings"
+	"syscall"
+
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+)
+
+// Values of the category attribute of redis.scrape.error.
+const (
+	scrapeErrorConnectionRefused = "connection_refused"
+	scrapeErrorTimeout           = "timeout"
+	scrapeErrorAuth              = "auth"
+	scrapeErrorTLS               = "tls"
+	scrapeErrorParse             = "parse"
+	scrapeErrorOther             = "other"
+)
+
+var scrapeErrorCategories = []string{
+	scrapeErrorConnectionRefused, scrapeErrorTimeout, scrapeErrorAuth, scrapeErrorTLS, scrapeErrorParse, scrapeErrorOther,
+}
+
+// errInfoParse is wrapped by the errors of INFO replies lacking the values
+// every scrape needs, e.g. the uptime.
+var errInfoParse = errors.New("unexpected INFO reply")
+
+// scrapeErrorCategory sorts the error of a failed scrape into one of the
+// categories of redis.scrape.error.
+func scrapeErrorCategory(err error) string {
+	var (
+		netErr         net.Error
+		recordErr      tls.RecordHeaderError
+		certErr        x509.CertificateInvalidError
+		unknownAuthErr x509.UnknownAuthorityError
+		hostnameErr    x509.HostnameError
+	)
+	switch {
+	case isAuthError(err) || errors.As(err, new(*authError)):
+		return scrapeErrorAuth
+	case errors.As(err, &recordErr) || errors.As(err, &certErr) || errors.As(err, &unknownAuthErr) ||
+		errors.As(err, &hostnameErr) || strings.Contains(err.Error(), "tls: "):
+		return scrapeErrorTLS
+	case errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()):
+		return scrapeErrorTimeout
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return scrapeErrorConnectionRefused
+	case errors.Is(err, errInfoParse):
+		return scrapeErrorParse
+	}
+	return scrapeErrorOther
+}
+
+// Counts the failed scrapes of a server per category since the collector
+// started, which unlike the metrics read from INFO does not restart with the
+// server. It records redis.scrape.error with a builder of its own, as the
+// builder of the scraper takes the start time of the server.
+type scrapeErrorCounter struct {
+	mb     *metadata.MetricsBuilder
+	counts map[string]int64
+}
+
+func newScrapeErrorCounter(settings metadata.MetricsSettings, start pdata.Timestamp) *scrapeErrorCounter {
+	counts := make(map[string]int64, len(scrapeErrorCategories))
+	for _, category := range scrapeErrorCategories {
+		counts[category] = 0
+	}
+	return &scrapeErrorCounter{
+		mb:     metadata.NewMetricsBuilder(metadata.MetricsSettings{RedisScrapeError: settings.RedisScrapeError}, metadata.WithStartTime(start)),
+		counts: counts,
+	}
+}
+
+// emit appends the redis.scrape.error sum, if enabled, with a data point per
+// category, including those without any error so far.
+func (c *scrapeErrorCounter) emit(ts pdata.Timestamp, metrics pdata.MetricSlice) {
+	for _, category := range scrapeErrorCategories {
+		c.mb.RecordRedisScrapeErrorDataPoint(ts, c.counts[category], category)
+	}
+	c.mb.Emit(metrics)
+}
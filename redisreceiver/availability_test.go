@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+func TestScrapeErrorCategory(t *testing.T) {
+	for _, tt := range []struct {
+		err      error
+		category string
+	}{
+		{
+			err:      &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)},
+			category: scrapeErrorConnectionRefused,
+		},
+		{
+			err:      &net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded},
+			category: scrapeErrorTimeout,
+		},
+		{err: context.DeadlineExceeded, category: scrapeErrorTimeout},
+		{err: errors.New("NOAUTH Authentication required."), category: scrapeErrorAuth},
+		{err: &authError{endpoint: "localhost:6379", err: errors.New("WRONGPASS")}, category: scrapeErrorAuth},
+		{err: x509.UnknownAuthorityError{}, category: scrapeErrorTLS},
+		{err: errors.New("remote error: tls: bad certificate"), category: scrapeErrorTLS},
+		{err: fmt.Errorf("%w: missing uptime", errInfoParse), category: scrapeErrorParse},
+		{err: errors.New("EOF"), category: scrapeErrorOther},
+	} {
+		assert.Equal(t, tt.category, scrapeErrorCategory(tt.err), tt.err.Error())
+	}
+}
+
+type refusingClient struct {
+	fakeClient
+}
+
+func (refusingClient) retrieveInfo([]string) (string, error) {
+	return "", &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+}
+
+func TestScrapeDown(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	rs := newNodeScraper(refusingClient{}, "localhost:6379", componenttest.NewNopReceiverCreateSettings(), cfg)
+
+	for i := 0; i < 2; i++ {
+		md, err := rs.Scrape(context.Background())
+		require.Error(t, err)
+		assert.True(t, scrapererror.IsPartialScrapeError(err))
+
+		require.Equal(t, 1, md.ResourceMetrics().Len())
+		assert.Equal(t, map[string]interface{}{"redis.endpoint": "localhost:6379"},
+			md.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+		assert.Equal(t, 7, md.DataPointCount())
+
+		up, ok := findMetric(md, "redis.up")
+		require.True(t, ok)
+		assert.EqualValues(t, 0, up.Gauge().DataPoints().At(0).IntVal())
+		assert.Equal(t, map[string]int64{
+			scrapeErrorConnectionRefused: int64(i + 1),
+			scrapeErrorTimeout:           0,
+			scrapeErrorAuth:              0,
+			scrapeErrorTLS:               0,
+			scrapeErrorParse:             0,
+			scrapeErrorOther:             0,
+		}, scrapeErrorCounts(t, md))
+	}
+}
+
+func TestScrapeDownWithoutScrapeErrors(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Metrics.RedisScrapeError.Enabled = false
+	rs := newNodeScraper(refusingClient{}, "localhost:6379", componenttest.NewNopReceiverCreateSettings(), cfg)
+
+	md, err := rs.Scrape(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, 1, md.DataPointCount())
+	_, ok := findMetric(md, "redis.scrape.error")
+	assert.False(t, ok)
+}
+
+func TestScrapeDownKeepsResourceAttributes(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	client := &switchingClient{}
+	rs := newNodeScraper(client, "localhost:6379", componenttest.NewNopReceiverCreateSettings(), cfg)
+
+	md, err := rs.Scrape(context.Background())
+	require.NoError(t, err)
+	up, ok := findMetric(md, "redis.up")
+	require.True(t, ok)
+	assert.EqualValues(t, 1, up.Gauge().DataPoints().At(0).IntVal())
+	attrs := md.ResourceMetrics().At(0).Resource().Attributes().AsRaw()
+
+	client.down = true
+	md, err = rs.Scrape(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, attrs, md.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+	up, ok = findMetric(md, "redis.up")
+	require.True(t, ok)
+	assert.EqualValues(t, 0, up.Gauge().DataPoints().At(0).IntVal())
+	assert.EqualValues(t, 1, scrapeErrorCounts(t, md)[scrapeErrorOther])
+}
+
+type switchingClient struct {
+	fakeClient
+	down bool
+}
+
+func (c *switchingClient) retrieveInfo(sections []string) (string, error) {
+	if c.down {
+		return "", errors.New("EOF")
+	}
+	return c.fakeClient.retrieveInfo(sections)
+}
+
+func scrapeErrorCounts(t *testing.T, md pdata.Metrics) map[string]int64 {
+	m, ok := findMetric(md, "redis.scrape.error")
+	require.True(t, ok)
+	assert.True(t, m.Sum().IsMonotonic())
+	counts := map[string]int64{}
+	dps := m.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		category, ok := dps.At(i).Attributes().Get("category")
+		require.True(t, ok)
+		counts[category.StringVal()] = dps.At(i).IntVal()
+	}
+	return counts
+}
@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/multierr"
+)
+
+// Holds the details of a sampled key.
+type keySample struct {
+	key     string
+	keyType string
+	memory  int64 // bytes, from MEMORY USAGE
+	length  int64 // elements, or bytes for strings
+}
+
+// Holds the largest keys by memory usage, largest first.
+type topKeys []*keySample
+
+// add inserts the sample if it is among the n largest, replacing a previous
+// sample of the same key.
+func (t topKeys) add(sample *keySample, n int) topKeys {
+	for i, s := range t {
+		if s.key == sample.key {
+			t = append(t[:i], t[i+1:]...)
+			break
+		}
+	}
+	i := sort.Search(len(t), func(i int) bool { return t[i].memory < sample.memory })
+	if i >= n {
+		return t
+	}
+	t = append(t, nil)
+	copy(t[i+1:], t[i:])
+	t[i] = sample
+	if len(t) > n {
+		t = t[:n]
+	}
+	return t
+}
+
+// Tracks the SCAN of a database across scrapes. A full pass over the keyspace
+// usually takes many scrapes; the largest keys of the previous pass are kept
+// until the current one completes so that keys that are not resampled yet do
+// not disappear.
+type bigKeyDB struct {
+	scanCursor
+	current  map[string]topKeys // by key type
+	previous map[string]topKeys // by key type
+}
+
+// Samples a fraction of the keys of every database on each scrape, within a
+// time budget, and keeps the largest keys per type.
+type bigKeySampler struct {
+	dbs map[int]*bigKeyDB
+}
+
+func newBigKeySampler() *bigKeySampler {
+	return &bigKeySampler{dbs: map[int]*bigKeyDB{}}
+}
+
+// sample scans the next sample_ratio of the keys of each database, given by
+// the number of keys of each db in INFO keyspace, each database getting a
+// share of the time budget.
+func (s *bigKeySampler) sample(c client, keyCounts map[int]int, settings BigKeysSettings) error {
+	deadline := time.Now().Add(settings.TimeBudget)
+	dbs := make([]int, 0, len(keyCounts))
+	for db := range keyCounts {
+		dbs = append(dbs, db)
+	}
+	sort.Ints(dbs)
+
+	var errs error
+	for i, db := range dbs {
+		state, ok := s.dbs[db]
+		if !ok {
+			state = &bigKeyDB{current: map[string]topKeys{}, previous: map[string]topKeys{}}
+			s.dbs[db] = state
+		}
+		quota := int(math.Ceil(float64(keyCounts[db]) * settings.SampleRatio))
+		completed, err := state.advance(func(cursor uint64, count int64) (uint64, error) {
+			samples, next, err := c.sampleKeys(db, cursor, count)
+			if err != nil {
+				return 0, err
+			}
+			for _, sample := range samples {
+				state.current[sample.keyType] = state.current[sample.keyType].add(sample, settings.TopN)
+			}
+			return next, nil
+		}, quota, settings.ScanCount, shareBudget(deadline, len(dbs)-i))
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("db %d: %w", db, err))
+		}
+		if completed {
+			state.previous, state.current = state.current, map[string]topKeys{}
+		}
+	}
+	// Databases that became empty are forgotten.
+	for db := range s.dbs {
+		if _, ok := keyCounts[db]; !ok {
+			delete(s.dbs, db)
+		}
+	}
+	return errs
+}
+
+// largest returns the n largest keys of each type of a database, from the
+// current pass and the previous one, the current one taking precedence.
+func (state *bigKeyDB) largest(n int) map[string]topKeys {
+	result := make(map[string]topKeys, len(state.current))
+	for keyType, previous := range state.previous {
+		for _, sample := range previous {
+			result[keyType] = result[keyType].add(sample, n)
+		}
+	}
+	for keyType, current := range state.current {
+		for _, sample := range current {
+			result[keyType] = result[keyType].add(sample, n)
+		}
+	}
+	return result
+}
+
+// recordBigKeyMetrics samples keys if enabled and records the memory usage and
+// length of the largest keys per database and type.
+func (rs *redisScraper) recordBigKeyMetrics(ts pdata.Timestamp, inf info) {
+	if !rs.cfg.BigKeys.Enabled {
+		return
+	}
+	keyCounts := map[int]int{}
+	for _, db := range inf.keyspaceDBs() {
+		keyspace, err := parseKeyspaceString(db, inf["db"+strconv.Itoa(db)])
+		if err != nil {
+			continue
+		}
+		keyCounts[db] = keyspace.keys
+	}
+	if rs.bigKeys == nil {
+		rs.bigKeys = newBigKeySampler()
+	}
+	if err := rs.bigKeys.sample(rs.redisSvc.client, keyCounts, rs.cfg.BigKeys); err != nil {
+		rs.errs.AddPartial(1, fmt.Errorf("failed to sample keys: %w", err))
+	}
+
+	for db, state := range rs.bigKeys.dbs {
+		dbStr := strconv.Itoa(db)
+		for keyType, keys := range state.largest(rs.cfg.BigKeys.TopN) {
+			for _, sample := range keys {
+				rs.mb.RecordRedisKeyMemoryUsageDataPoint(ts, sample.memory, dbStr, sample.key, keyType)
+				rs.mb.RecordRedisKeyLengthDataPoint(ts, sample.length, dbStr, sample.key, keyType)
+			}
+		}
+	}
+}
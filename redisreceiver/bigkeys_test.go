@@ -0,0 +1,205 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestTopKeysAdd(t *testing.T) {
+	var top topKeys
+	top = top.add(&keySample{key: "a", memory: 10}, 2)
+	top = top.add(&keySample{key: "b", memory: 30}, 2)
+	top = top.add(&keySample{key: "c", memory: 20}, 2)
+	require.Len(t, top, 2)
+	assert.Equal(t, "b", top[0].key)
+	assert.Equal(t, "c", top[1].key)
+
+	// a resampled key replaces its previous sample
+	top = top.add(&keySample{key: "b", memory: 5}, 2)
+	require.Len(t, top, 2)
+	assert.Equal(t, "c", top[0].key)
+	assert.Equal(t, int64(5), top[1].memory)
+
+	top = top.add(&keySample{key: "d", memory: 1}, 2)
+	require.Len(t, top, 2)
+	assert.Equal(t, "b", top[1].key)
+}
+
+// keysFakeClient holds the keys of db0, scanned in order count keys at a time,
+// failing with err if set. Other databases are empty.
+type keysFakeClient struct {
+	fakeClient
+	keys    []*keySample
+	scanned int
+	delay   time.Duration
+	err     error
+	dbs     map[int]bool // scanned databases
+}
+
+func (c *keysFakeClient) sampleKeys(db int, cursor uint64, count int64) ([]*keySample, uint64, error) {
+	if c.dbs == nil {
+		c.dbs = map[int]bool{}
+	}
+	c.dbs[db] = true
+	if db != 0 {
+		return nil, 0, nil
+	}
+	if c.err != nil {
+		return nil, 0, c.err
+	}
+	time.Sleep(c.delay)
+	end := int(cursor) + int(count)
+	if end >= len(c.keys) {
+		end = len(c.keys)
+	}
+	c.scanned += end - int(cursor)
+	if end == len(c.keys) {
+		return c.keys[cursor:end], 0, nil
+	}
+	return c.keys[cursor:end], uint64(end), nil
+}
+
+// measureKeys scans the keys like sampleKeys, the keys of type "unreadable"
+// having no memory usage.
+func (c *keysFakeClient) measureKeys(db int, cursor uint64, count int64) ([]*keySample, uint64, error) {
+	samples, next, err := c.sampleKeys(db, cursor, count)
+	measured := make([]*keySample, len(samples))
+	for i, sample := range samples {
+		measured[i] = &keySample{key: sample.key}
+		if sample.keyType != "unreadable" {
+			measured[i].memory = sample.memory
+		}
+	}
+	return measured, next, err
+}
+
+func newKeysFakeClient() *keysFakeClient {
+	c := &keysFakeClient{}
+	for i := 0; i < 100; i++ {
+		keyType := "string"
+		if i%2 == 1 {
+			keyType = "hash"
+		}
+		c.keys = append(c.keys, &keySample{key: fmt.Sprintf("key:%d", i), keyType: keyType, memory: int64(i * 100), length: int64(i)})
+	}
+	return c
+}
+
+func TestBigKeySampler(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.BigKeys.SampleRatio = 0.3
+	cfg.BigKeys.ScanCount = 10
+	cfg.BigKeys.TopN = 2
+	cfg.BigKeys.TimeBudget = time.Minute
+
+	c := newKeysFakeClient()
+	s := newBigKeySampler()
+	require.NoError(t, s.sample(c, map[int]int{0: 100}, cfg.BigKeys))
+	// a ratio of the keys is sampled on each scrape
+	assert.Equal(t, 30, c.scanned)
+	largest := s.dbs[0].largest(2)
+	assert.Equal(t, []string{"key:28", "key:26"}, []string{largest["string"][0].key, largest["string"][1].key})
+	assert.Equal(t, []string{"key:29", "key:27"}, []string{largest["hash"][0].key, largest["hash"][1].key})
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, s.sample(c, map[int]int{0: 100}, cfg.BigKeys))
+	}
+	// the pass completed, so the largest keys of the whole keyspace are known
+	assert.Equal(t, uint64(0), s.dbs[0].cursor)
+	largest = s.dbs[0].largest(2)
+	assert.Equal(t, "key:98", largest["string"][0].key)
+	assert.Equal(t, "key:99", largest["hash"][0].key)
+
+	// the largest keys are kept while the next pass starts over
+	require.NoError(t, s.sample(c, map[int]int{0: 100}, cfg.BigKeys))
+	assert.Equal(t, "key:99", s.dbs[0].largest(2)["hash"][0].key)
+
+	// empty databases are forgotten
+	require.NoError(t, s.sample(c, map[int]int{}, cfg.BigKeys))
+	assert.Empty(t, s.dbs)
+}
+
+func TestBigKeySamplerTimeBudget(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.BigKeys.SampleRatio = 1
+	cfg.BigKeys.ScanCount = 10
+	cfg.BigKeys.TimeBudget = 15 * time.Millisecond
+
+	c := newKeysFakeClient()
+	c.delay = 10 * time.Millisecond
+	require.NoError(t, newBigKeySampler().sample(c, map[int]int{0: 100}, cfg.BigKeys))
+	assert.Less(t, c.scanned, 100)
+
+	// a large database does not use up the budget of the others
+	c = newKeysFakeClient()
+	c.delay = 10 * time.Millisecond
+	cfg.BigKeys.TimeBudget = 40 * time.Millisecond
+	require.NoError(t, newBigKeySampler().sample(c, map[int]int{0: 100, 1: 10}, cfg.BigKeys))
+	assert.Less(t, c.scanned, 100)
+	assert.True(t, c.dbs[1])
+}
+
+func TestBigKeySamplerError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.BigKeys.TimeBudget = time.Minute
+	c := newKeysFakeClient()
+	c.err = errors.New("ERR command not allowed")
+	s := newBigKeySampler()
+	s.dbs[2] = &bigKeyDB{}
+
+	err := s.sample(c, map[int]int{0: 100, 1: 10}, cfg.BigKeys)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "db 0")
+	// the other databases are still sampled, and empty ones forgotten
+	assert.True(t, c.dbs[1])
+	assert.NotContains(t, s.dbs, 2)
+}
+
+func TestRedisScraperBigKeys(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	runner, err := newRedisScraperWithClient(newKeysFakeClient(), componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	// opt-in
+	_, ok := findMetric(md, "redis.key.memory_usage")
+	assert.False(t, ok)
+
+	cfg.BigKeys.Enabled = true
+	cfg.BigKeys.SampleRatio = 1
+	cfg.BigKeys.TimeBudget = time.Minute
+	runner, err = newRedisScraperWithClient(newKeysFakeClient(), componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err = runner.Scrape(context.Background())
+	require.NoError(t, err)
+
+	// testdata/info.txt has one key in db0
+	m, ok := findMetric(md, "redis.key.memory_usage")
+	require.True(t, ok)
+	require.Equal(t, 1, m.Gauge().DataPoints().Len())
+	assert.Equal(t, map[string]interface{}{"db": "0", "key": "key:0", "type": "string"},
+		m.Gauge().DataPoints().At(0).Attributes().AsRaw())
+	_, ok = findMetric(md, "redis.key.length")
+	assert.True(t, ok)
+}
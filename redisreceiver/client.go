@@ -15,23 +15,84 @@
 package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
 
 import (
+	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-redis/redis/v7"
+	"go.uber.org/multierr"
 )
 
 // Interface for a Redis client. Implementation can be faked for testing.
 type client interface {
-	// retrieves a string of key/value pairs of redis metadata
-	retrieveInfo() (string, error)
+	// retrieves a string of key/value pairs of redis metadata from the INFO
+	// sections, or from the default ones if there are none
+	retrieveInfo(sections []string) (string, error)
+	// retrieves the node table returned by CLUSTER NODES
+	retrieveClusterNodes() (string, error)
+	// retrieves the state of every master monitored by a sentinel
+	retrieveSentinelMasters() ([]map[string]string, error)
+	// retrieves the state of the replicas of the named master from a sentinel
+	retrieveSentinelReplicas(name string) ([]map[string]string, error)
+	// retrieves the CLIENT LIST table, one connection per line
+	retrieveClientList() (string, error)
+	// scans a batch of keys of database db starting at cursor and retrieves
+	// their type, memory usage and length, returning the next cursor
+	sampleKeys(db int, cursor uint64, count int64) ([]*keySample, uint64, error)
+	// scans a batch of keys of database db starting at cursor and retrieves
+	// their memory usage only, 0 if it cannot be read, returning the next
+	// cursor
+	measureKeys(db int, cursor uint64, count int64) ([]*keySample, uint64, error)
+	// scans a batch of keys of database db starting at cursor and returns
+	// those matching the glob-style pattern, and the next cursor
+	matchKeys(db int, pattern string, cursor uint64, count int64) ([]string, uint64, error)
+	// retrieves the type and length of keys of database db, reporting missing
+	// keys with type "none"
+	retrieveKeyLengths(db int, keys []string) ([]*keySample, error)
+	// scans a batch of keys of database db starting at cursor and returns
+	// those of type stream, and the next cursor
+	discoverStreams(db int, cursor uint64, count int64) ([]string, uint64, error)
+	// retrieves the consumer groups of the streams of database db along with
+	// their consumers, skipping streams that do not exist
+	retrieveStreamGroups(db int, streams []string) ([]*streamGroup, error)
+	// retrieves the Pub/Sub channels with subscribers matching the glob-style
+	// pattern, or the sharded channels of Redis 7 if sharded
+	retrieveChannels(pattern string, sharded bool) ([]string, error)
+	// retrieves the number of subscribers of every channel, or of every
+	// sharded channel of Redis 7 if sharded
+	retrieveChannelSubscribers(channels []string, sharded bool) (map[string]int64, error)
+	// sends PING
+	ping() error
+	// writes value to key with the ttl then reads it back, failing if the
+	// value read differs
+	probeCanary(key string, value string, ttl time.Duration) error
+	// writes the heartbeat time to key with the ttl
+	writeHeartbeat(key string, t time.Time, ttl time.Duration) error
+	// reads the heartbeat time from key, false if there is none
+	readHeartbeat(key string) (time.Time, bool, error)
+	// retrieves the values of the server configuration parameters, which can
+	// be glob-style patterns
+	retrieveConfig(parameters []string) (map[string]string, error)
+	// retrieves at most count of the most recent SLOWLOG entries, newest first
+	retrieveSlowLog(count int64) ([]*slowLogEntry, error)
+	// retrieves the per-command latency histograms of LATENCY HISTOGRAM
+	retrieveLatencyHistogram() ([]*latencyHistogram, error)
 	// line delimiter
 	// redis lines are delimited by \r\n, files (for testing) by \n
 	delimiter() string
+	// releases the connections held by the client
+	close() error
 }
 
 // Wraps a real Redis client, implements `client` interface.
 type redisClient struct {
 	client *redis.Client
+	// clients of the databases other than the one of client, keyed by db
+	dbClients map[int]*redis.Client
+	// set once the server rejected INFO with several sections, as servers
+	// before Redis 7.0 do
+	singleSectionInfo bool
 }
 
 var _ client = (*redisClient)(nil)
@@ -43,27 +104,451 @@ func newRedisClient(options *redis.Options) client {
 	}
 }
 
+// Creates clients for other servers, e.g. discovered cluster nodes.
+type clientFactory func(addr string) client
+
+// Returns a clientFactory whose clients share the passed-in redis.Options,
+// apart from the address.
+func newClientFactory(options *redis.Options) clientFactory {
+	return func(addr string) client {
+		nodeOptions := *options
+		nodeOptions.Addr = addr
+		return newRedisClient(&nodeOptions)
+	}
+}
+
 // Redis strings are CRLF delimited.
 func (c *redisClient) delimiter() string {
 	return "\r\n"
 }
 
-// Retrieve Redis INFO. We retrieve all of the 'sections'.
-func (c *redisClient) retrieveInfo() (string, error) {
-	defaultInfo, err := c.client.Info().Result()
+// infoSectionsError reports the INFO sections a server failed to return while
+// it returned the others, e.g. sections blocked by a managed service.
+type infoSectionsError struct {
+	sections []string
+	err      error
+}
+
+func (e *infoSectionsError) Error() string {
+	return fmt.Sprintf("failed to retrieve INFO sections %s: %v", strings.Join(e.sections, ", "), e.err)
+}
+
+func (e *infoSectionsError) Unwrap() error {
+	return e.err
+}
+
+// Retrieve Redis INFO with a single command, or with one command per section
+// sent in a pipeline to servers that only take a single section. When the
+// server replies to several sections with an error, each section is retried
+// on its own, returning those that succeeded along with an
+// *infoSectionsError.
+func (c *redisClient) retrieveInfo(sections []string) (string, error) {
+	if len(sections) < 2 || !c.singleSectionInfo {
+		str, err := c.client.Info(sections...).Result()
+		var redisErr redis.Error
+		if err == nil || len(sections) < 2 || !errors.As(err, &redisErr) {
+			return str, err
+		}
+		if strings.HasPrefix(err.Error(), "ERR syntax error") {
+			c.singleSectionInfo = true
+		}
+	}
+
+	pipe := c.client.Pipeline()
+	cmds := make([]*redis.StringCmd, len(sections))
+	for i, section := range sections {
+		cmds[i] = pipe.Info(section)
+	}
+	// The error of each section is checked below.
+	_, _ = pipe.Exec()
+	var strs, failed []string
+	var errs error
+	for i, cmd := range cmds {
+		if err := cmd.Err(); err != nil {
+			failed = append(failed, sections[i])
+			errs = multierr.Append(errs, err)
+			continue
+		}
+		strs = append(strs, cmd.Val())
+	}
+	if len(strs) == 0 {
+		return "", cmds[0].Err()
+	}
+	str := strings.Join(strs, c.delimiter())
+	if len(failed) > 0 {
+		return str, &infoSectionsError{sections: failed, err: errs}
+	}
+	return str, nil
+}
+
+// Retrieve the CLUSTER NODES table, one node per line.
+func (c *redisClient) retrieveClusterNodes() (string, error) {
+	return c.client.ClusterNodes().Result()
+}
+
+// Retrieve SENTINEL MASTERS.
+func (c *redisClient) retrieveSentinelMasters() ([]map[string]string, error) {
+	cmd := redis.NewSliceCmd("sentinel", "masters")
+	if err := c.client.Process(cmd); err != nil {
+		return nil, err
+	}
+	return parseSentinelReply(cmd.Val())
+}
+
+// Retrieve SENTINEL SLAVES, which older sentinels support unlike SENTINEL REPLICAS.
+func (c *redisClient) retrieveSentinelReplicas(name string) ([]map[string]string, error) {
+	cmd := redis.NewSliceCmd("sentinel", "slaves", name)
+	if err := c.client.Process(cmd); err != nil {
+		return nil, err
+	}
+	return parseSentinelReply(cmd.Val())
+}
+
+// dbClient returns a client of the database. Databases other than the one of
+// the main client get a client of their own, as a connection that ran SELECT
+// would go back to the shared pool still on the selected database, where the
+// commands of the other features would then run.
+func (c *redisClient) dbClient(db int) *redis.Client {
+	if db == c.client.Options().DB {
+		return c.client
+	}
+	if dbClient, ok := c.dbClients[db]; ok {
+		return dbClient
+	}
+	options := *c.client.Options()
+	// go-redis runs SELECT before OnConnect, which would fail on servers
+	// requiring the password OnConnect authenticates with when password_file
+	// is set, so SELECT is left to OnConnect.
+	onConnect := options.OnConnect
+	options.DB = 0
+	options.OnConnect = func(conn *redis.Conn) error {
+		if onConnect != nil {
+			if err := onConnect(conn); err != nil {
+				return err
+			}
+		}
+		return conn.Select(db).Err()
+	}
+	// The scraper sends a single command at a time.
+	options.PoolSize = 1
+	options.MinIdleConns = 0
+	if c.dbClients == nil {
+		c.dbClients = map[int]*redis.Client{}
+	}
+	c.dbClients[db] = redis.NewClient(&options)
+	return c.dbClients[db]
+}
+
+// Retrieve the CLIENT LIST table.
+func (c *redisClient) retrieveClientList() (string, error) {
+	return c.client.ClientList().Result()
+}
+
+// Scan a batch of keys of a database and retrieve their details.
+func (c *redisClient) sampleKeys(db int, cursor uint64, count int64) ([]*keySample, uint64, error) {
+	conn := c.dbClient(db)
+	keys, next, err := conn.Scan(cursor, "", count).Result()
+	if err != nil || len(keys) == 0 {
+		return nil, next, err
+	}
+
+	pipe := conn.Pipeline()
+	typeCmds := make([]*redis.StatusCmd, len(keys))
+	memoryCmds := make([]*redis.IntCmd, len(keys))
+	for i, key := range keys {
+		typeCmds[i] = pipe.Type(key)
+		memoryCmds[i] = pipe.MemoryUsage(key)
+	}
+	// Keys deleted since the scan fail individually and are skipped below.
+	_, _ = pipe.Exec()
+
+	samples := make([]*keySample, 0, len(keys))
+	pipe = conn.Pipeline()
+	lengthCmds := make([]*redis.IntCmd, 0, len(keys))
+	for i, key := range keys {
+		keyType, typeErr := typeCmds[i].Result()
+		memory, memoryErr := memoryCmds[i].Result()
+		if typeErr != nil || memoryErr != nil {
+			continue
+		}
+		lengthCmd := keyLengthCmd(pipe, keyType, key)
+		if lengthCmd == nil {
+			continue
+		}
+		samples = append(samples, &keySample{key: key, keyType: keyType, memory: memory})
+		lengthCmds = append(lengthCmds, lengthCmd)
+	}
+	if len(samples) > 0 {
+		_, _ = pipe.Exec()
+	}
+	for i, sample := range samples {
+		sample.length = lengthCmds[i].Val()
+	}
+	return samples, next, nil
+}
+
+// Scan a batch of keys of a database and retrieve their memory usage. Keys
+// whose memory usage fails, e.g. deleted since the scan, are still returned.
+func (c *redisClient) measureKeys(db int, cursor uint64, count int64) ([]*keySample, uint64, error) {
+	conn := c.dbClient(db)
+	keys, next, err := conn.Scan(cursor, "", count).Result()
+	if err != nil || len(keys) == 0 {
+		return nil, next, err
+	}
+
+	pipe := conn.Pipeline()
+	memoryCmds := make([]*redis.IntCmd, len(keys))
+	for i, key := range keys {
+		memoryCmds[i] = pipe.MemoryUsage(key)
+	}
+	_, _ = pipe.Exec()
+
+	samples := make([]*keySample, len(keys))
+	for i, key := range keys {
+		samples[i] = &keySample{key: key, memory: memoryCmds[i].Val()}
+	}
+	return samples, next, nil
+}
+
+// Scan a batch of keys of a database with SCAN MATCH.
+func (c *redisClient) matchKeys(db int, pattern string, cursor uint64, count int64) ([]string, uint64, error) {
+	return c.dbClient(db).Scan(cursor, pattern, count).Result()
+}
+
+// Retrieve the type then the length of the keys of a database.
+func (c *redisClient) retrieveKeyLengths(db int, keys []string) ([]*keySample, error) {
+	conn := c.dbClient(db)
+
+	pipe := conn.Pipeline()
+	typeCmds := make([]*redis.StatusCmd, len(keys))
+	for i, key := range keys {
+		typeCmds[i] = pipe.Type(key)
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return nil, err
+	}
+
+	samples := make([]*keySample, len(keys))
+	lengthCmds := make([]*redis.IntCmd, len(keys))
+	pipe = conn.Pipeline()
+	for i, key := range keys {
+		samples[i] = &keySample{key: key, keyType: typeCmds[i].Val()}
+		lengthCmds[i] = keyLengthCmd(pipe, samples[i].keyType, key)
+	}
+	// A key whose type changed in between fails individually and is reported
+	// with a length of 0.
+	_, _ = pipe.Exec()
+	for i, lengthCmd := range lengthCmds {
+		if lengthCmd != nil {
+			samples[i].length = lengthCmd.Val()
+		}
+	}
+	return samples, nil
+}
+
+// keyLengthCmd queues the command returning the length of a key of the given
+// type, or returns nil for types without one, e.g. "none" for deleted keys.
+func keyLengthCmd(pipe redis.Pipeliner, keyType string, key string) *redis.IntCmd {
+	switch keyType {
+	case "string":
+		return pipe.StrLen(key)
+	case "list":
+		return pipe.LLen(key)
+	case "hash":
+		return pipe.HLen(key)
+	case "set":
+		return pipe.SCard(key)
+	case "zset":
+		return pipe.ZCard(key)
+	case "stream":
+		return pipe.XLen(key)
+	}
+	return nil
+}
+
+// Scan a batch of keys of a database for streams with SCAN TYPE, which
+// go-redis v7 does not implement.
+func (c *redisClient) discoverStreams(db int, cursor uint64, count int64) ([]string, uint64, error) {
+	cmd := redis.NewCmd("scan", cursor, "count", count, "type", "stream")
+	if err := c.dbClient(db).Process(cmd); err != nil {
+		return nil, 0, err
+	}
+	return parseScanReply(cmd.Val())
+}
+
+// Retrieve XINFO GROUPS of every stream, then XINFO CONSUMERS of every group.
+// Both are parsed by hand as go-redis v7 does not return the fields added by
+// Redis 7.
+func (c *redisClient) retrieveStreamGroups(db int, streams []string) ([]*streamGroup, error) {
+	conn := c.dbClient(db)
+
+	pipe := conn.Pipeline()
+	groupCmds := make([]*redis.Cmd, len(streams))
+	for i, stream := range streams {
+		groupCmds[i] = pipe.Do("xinfo", "groups", stream)
+	}
+	// Streams that do not exist fail individually and are skipped below.
+	_, _ = pipe.Exec()
+
+	var groups []*streamGroup
+	for i, cmd := range groupCmds {
+		val, err := cmd.Result()
+		if err != nil {
+			continue
+		}
+		streamGroups, err := parseStreamGroups(streams[i], val)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, streamGroups...)
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	pipe = conn.Pipeline()
+	consumerCmds := make([]*redis.Cmd, len(groups))
+	for i, group := range groups {
+		consumerCmds[i] = pipe.Do("xinfo", "consumers", group.stream, group.name)
+	}
+	// Groups destroyed in between fail individually and have no consumers.
+	_, _ = pipe.Exec()
+	for i, cmd := range consumerCmds {
+		val, err := cmd.Result()
+		if err != nil {
+			continue
+		}
+		if groups[i].consumers, err = parseStreamConsumers(val); err != nil {
+			return nil, err
+		}
+	}
+	return groups, nil
+}
+
+// Retrieve PUBSUB CHANNELS, or PUBSUB SHARDCHANNELS which go-redis v7 does
+// not implement.
+func (c *redisClient) retrieveChannels(pattern string, sharded bool) ([]string, error) {
+	if !sharded {
+		return c.client.PubSubChannels(pattern).Result()
+	}
+	val, err := c.client.Do("pubsub", "shardchannels", pattern).Result()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	return parseChannelsReply(val)
+}
 
-	commandstatsInfo, err := c.client.Info("commandstats").Result()
+// Retrieve PUBSUB NUMSUB, or PUBSUB SHARDNUMSUB whose reply has the same
+// layout.
+func (c *redisClient) retrieveChannelSubscribers(channels []string, sharded bool) (map[string]int64, error) {
+	if !sharded {
+		return c.client.PubSubNumSub(channels...).Result()
+	}
+	args := make([]interface{}, 0, 2+len(channels))
+	args = append(args, "pubsub", "shardnumsub")
+	for _, channel := range channels {
+		args = append(args, channel)
+	}
+	val, err := c.client.Do(args...).Result()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	return parseNumSubReply(val)
+}
 
-	lantencystatsInfo, err := c.client.Info("latencystats").Result()
+// Send PING.
+func (c *redisClient) ping() error {
+	return c.client.Ping().Err()
+}
+
+// Send SET then GET of the canary key in a pipeline, taking a single round
+// trip like PING.
+func (c *redisClient) probeCanary(key string, value string, ttl time.Duration) error {
+	pipe := c.client.Pipeline()
+	pipe.Set(key, value, ttl)
+	get := pipe.Get(key)
+	if _, err := pipe.Exec(); err != nil {
+		return err
+	}
+	if get.Val() != value {
+		return fmt.Errorf("canary key %q read back '%s' instead of '%s'", key, get.Val(), value)
+	}
+	return nil
+}
+
+// The heartbeat is stored as the number of nanoseconds since the Unix epoch.
+func (c *redisClient) writeHeartbeat(key string, t time.Time, ttl time.Duration) error {
+	return c.client.Set(key, t.UnixNano(), ttl).Err()
+}
+
+func (c *redisClient) readHeartbeat(key string) (time.Time, bool, error) {
+	nanos, err := c.client.Get(key).Int64()
+	if err == redis.Nil {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return time.Unix(0, nanos), true, nil
+}
+
+// Retrieve CONFIG GET of every parameter in a pipeline, as servers before
+// Redis 7.0 only take a single parameter.
+func (c *redisClient) retrieveConfig(parameters []string) (map[string]string, error) {
+	pipe := c.client.Pipeline()
+	cmds := make([]*redis.SliceCmd, len(parameters))
+	for i, parameter := range parameters {
+		cmds[i] = pipe.ConfigGet(parameter)
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return nil, err
+	}
+	config := map[string]string{}
+	for _, cmd := range cmds {
+		vals := cmd.Val()
+		for i := 0; i+1 < len(vals); i += 2 {
+			name, nameOk := vals[i].(string)
+			val, valOk := vals[i+1].(string)
+			if !nameOk || !valOk {
+				return nil, fmt.Errorf("unexpected config get reply '%v'", vals)
+			}
+			config[name] = val
+		}
+	}
+	return config, nil
+}
+
+// Retrieve SLOWLOG GET. go-redis v7 does not implement the command, so the
+// reply is parsed by hand.
+func (c *redisClient) retrieveSlowLog(count int64) ([]*slowLogEntry, error) {
+	val, err := c.client.Do("slowlog", "get", count).Result()
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	vals, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected slowlog reply '%v'", val)
 	}
+	return parseSlowLog(vals)
+}
 
-	return strings.Join([]string{defaultInfo, commandstatsInfo, lantencystatsInfo}, c.delimiter()), nil
+// Retrieve LATENCY HISTOGRAM for every command, parsed by hand like SLOWLOG.
+func (c *redisClient) retrieveLatencyHistogram() ([]*latencyHistogram, error) {
+	val, err := c.client.Do("latency", "histogram").Result()
+	if err != nil {
+		return nil, err
+	}
+	vals, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected latency histogram reply '%v'", val)
+	}
+	return parseLatencyHistogram(vals)
+}
+
+func (c *redisClient) close() error {
+	errs := c.client.Close()
+	for _, dbClient := range c.dbClients {
+		errs = multierr.Append(errs, dbClient.Close())
+	}
+	return errs
 }
@@ -15,12 +15,21 @@
 package redisreceiver
 
 import (
+	"bufio"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/go-redis/redis/v7"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -40,10 +49,105 @@ func (c fakeClient) delimiter() string {
 	return "\n"
 }
 
-func (fakeClient) retrieveInfo() (string, error) {
+func (fakeClient) retrieveInfo([]string) (string, error) {
 	return readFile("info")
 }
 
+func (fakeClient) retrieveClusterNodes() (string, error) {
+	return readFile("cluster_nodes")
+}
+
+func (fakeClient) retrieveSentinelMasters() ([]map[string]string, error) {
+	return nil, nil
+}
+
+func (fakeClient) retrieveSentinelReplicas(string) ([]map[string]string, error) {
+	return nil, nil
+}
+
+func (fakeClient) retrieveClientList() (string, error) {
+	return readFile("client_list")
+}
+
+func (fakeClient) sampleKeys(int, uint64, int64) ([]*keySample, uint64, error) {
+	return nil, 0, nil
+}
+
+func (fakeClient) measureKeys(int, uint64, int64) ([]*keySample, uint64, error) {
+	return nil, 0, nil
+}
+
+func (fakeClient) matchKeys(int, string, uint64, int64) ([]string, uint64, error) {
+	return nil, 0, nil
+}
+
+func (fakeClient) retrieveKeyLengths(int, []string) ([]*keySample, error) {
+	return nil, nil
+}
+
+func (fakeClient) discoverStreams(int, uint64, int64) ([]string, uint64, error) {
+	return nil, 0, nil
+}
+
+func (fakeClient) retrieveStreamGroups(int, []string) ([]*streamGroup, error) {
+	return nil, nil
+}
+
+func (fakeClient) retrieveChannels(string, bool) ([]string, error) {
+	return nil, nil
+}
+
+func (fakeClient) retrieveChannelSubscribers([]string, bool) (map[string]int64, error) {
+	return nil, nil
+}
+
+func (fakeClient) ping() error {
+	return nil
+}
+
+func (fakeClient) probeCanary(string, string, time.Duration) error {
+	return nil
+}
+
+func (fakeClient) writeHeartbeat(string, time.Time, time.Duration) error {
+	return nil
+}
+
+func (fakeClient) readHeartbeat(string) (time.Time, bool, error) {
+	return time.Time{}, false, nil
+}
+
+func (fakeClient) retrieveConfig([]string) (map[string]string, error) {
+	return nil, nil
+}
+
+func (fakeClient) retrieveSlowLog(int64) ([]*slowLogEntry, error) {
+	return nil, nil
+}
+
+func (fakeClient) retrieveLatencyHistogram() ([]*latencyHistogram, error) {
+	return nil, nil
+}
+
+func (fakeClient) close() error {
+	return nil
+}
+
+// replacingFakeClient serves testdata/info.txt with some of its lines replaced.
+type replacingFakeClient struct {
+	fakeClient
+	replacer *strings.Replacer
+}
+
+func newReplacingFakeClient(oldnew ...string) *replacingFakeClient {
+	return &replacingFakeClient{replacer: strings.NewReplacer(oldnew...)}
+}
+
+func (c *replacingFakeClient) retrieveInfo([]string) (string, error) {
+	str, err := c.fakeClient.retrieveInfo(nil)
+	return c.replacer.Replace(str), err
+}
+
 func readFile(fname string) (string, error) {
 	file, err := ioutil.ReadFile(filepath.Join("testdata", fname+".txt"))
 	if err != nil {
@@ -54,21 +158,182 @@ func readFile(fname string) (string, error) {
 
 func TestRetrieveInfo(t *testing.T) {
 	g := fakeClient{}
-	res, err := g.retrieveInfo()
+	res, err := g.retrieveInfo(nil)
 	require.Nil(t, err)
 	require.True(t, strings.HasPrefix(res, "# Server"))
 }
 
 func TestRetrieveInfoCommandStats(t *testing.T) {
 	g := fakeClient{}
-	res, err := g.retrieveInfo()
+	res, err := g.retrieveInfo(nil)
 	require.Nil(t, err)
 	require.True(t, strings.Contains(res, "# Commandstats"))
 }
 
 func TestRetrieveInfoLatencyStats(t *testing.T) {
 	g := fakeClient{}
-	res, err := g.retrieveInfo()
+	res, err := g.retrieveInfo(nil)
 	require.Nil(t, err)
 	require.True(t, strings.Contains(res, "# Latencystats"))
 }
+
+// respServer is a Redis server speaking just enough RESP to tell which
+// database each command ran on, and to act as a sentinel monitoring a single
+// master without replicas.
+type respServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	values   map[int]map[string]string // keyed by db then key
+	scanned  []int                     // the db of every SCAN
+	password string                    // required by AUTH if set
+}
+
+func newRESPServer(t *testing.T) *respServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &respServer{listener: listener, values: map[int]map[string]string{}}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *respServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	db := 0
+	authenticated := s.password == ""
+	for {
+		args, err := readRESPCommand(r)
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		var reply string
+		switch cmd := strings.ToLower(args[0]); {
+		case cmd == "auth":
+			authenticated = args[len(args)-1] == s.password
+			reply = "+OK\r\n"
+			if !authenticated {
+				reply = "-WRONGPASS invalid username-password pair\r\n"
+			}
+		case !authenticated:
+			reply = "-NOAUTH Authentication required.\r\n"
+		case cmd == "select":
+			db, _ = strconv.Atoi(args[1])
+			reply = "+OK\r\n"
+		case cmd == "scan":
+			s.scanned = append(s.scanned, db)
+			reply = "*2\r\n$1\r\n0\r\n*0\r\n"
+		case cmd == "set":
+			if s.values[db] == nil {
+				s.values[db] = map[string]string{}
+			}
+			s.values[db][args[1]] = args[2]
+			reply = "+OK\r\n"
+		case cmd == "get":
+			if val, ok := s.values[db][args[1]]; ok {
+				reply = fmt.Sprintf("$%d\r\n%s\r\n", len(val), val)
+			} else {
+				reply = "$-1\r\n"
+			}
+		case cmd == "sentinel" && strings.ToLower(args[1]) == "masters":
+			reply = "*1\r\n*4\r\n$4\r\nname\r\n$8\r\nmymaster\r\n$5\r\nflags\r\n$6\r\nmaster\r\n"
+		case cmd == "sentinel" && strings.ToLower(args[1]) == "slaves":
+			reply = "*0\r\n"
+		default:
+			reply = "-ERR unknown command\r\n"
+		}
+		s.mu.Unlock()
+		if _, err := conn.Write([]byte(reply)); err != nil {
+			return
+		}
+	}
+}
+
+func readRESPCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		if line, err = r.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "$")))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+	return args, nil
+}
+
+func TestRedisClientOtherDBs(t *testing.T) {
+	s := newRESPServer(t)
+	c := newRedisClient(&redis.Options{Addr: s.listener.Addr().String(), PoolSize: 1})
+	defer c.close()
+
+	_, _, err := c.matchKeys(3, "user:*", 0, 10)
+	require.NoError(t, err)
+	_, _, err = c.sampleKeys(5, 0, 10)
+	require.NoError(t, err)
+	require.NoError(t, c.writeHeartbeat("otel:heartbeat", time.Unix(0, 42), time.Minute))
+	require.NoError(t, c.probeCanary("otel:canary", "1", time.Minute))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	assert.Equal(t, []int{3, 5}, s.scanned)
+	// the commands of the other features still run on the default database
+	assert.Equal(t, map[int]map[string]string{0: {"otel:heartbeat": "42", "otel:canary": "1"}}, s.values)
+}
+
+func TestRedisClientOtherDBsWithPasswordFile(t *testing.T) {
+	s := newRESPServer(t)
+	s.password = "secret"
+	path := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, ioutil.WriteFile(path, []byte("secret\n"), 0600))
+	c := newRedisClient(&redis.Options{
+		Addr:      s.listener.Addr().String(),
+		PoolSize:  1,
+		OnConnect: authenticateWithPasswordFile("", path),
+	})
+	defer c.close()
+
+	// the connection of db 1 authenticates before selecting it
+	_, _, err := c.matchKeys(1, "user:*", 0, 10)
+	require.NoError(t, err)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	assert.Equal(t, []int{1}, s.scanned)
+}
+
+func TestRedisClientSentinel(t *testing.T) {
+	s := newRESPServer(t)
+	s.password = "secret"
+	c := newRedisClient(&redis.Options{Addr: s.listener.Addr().String(), Password: "secret"})
+	defer c.close()
+
+	masters, err := c.retrieveSentinelMasters()
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]string{{"name": "mymaster", "flags": "master"}}, masters)
+	replicas, err := c.retrieveSentinelReplicas("mymaster")
+	require.NoError(t, err)
+	assert.Empty(t, replicas)
+}
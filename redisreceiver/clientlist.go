@@ -0,0 +1,242 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/model/pdata"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+)
+
+// Fields of CLIENT LIST that client connections can be grouped by.
+const (
+	clientGroupName    = "name"
+	clientGroupLibName = "lib_name"
+	clientGroupUser    = "user"
+	clientGroupDB      = "db"
+	clientGroupFlags   = "flags"
+)
+
+// The value of every grouped field of the group collecting the connections
+// that do not fit under max_groups. CLIENT LIST fields are separated by
+// spaces, so no actual value can contain one and collide with it.
+const clientGroupOther = "(other groups)"
+
+// Maps the group_by fields to the keys of CLIENT LIST.
+var clientGroupKeys = map[string]string{
+	clientGroupName:    "name",
+	clientGroupLibName: "lib-name",
+	clientGroupUser:    "user",
+	clientGroupDB:      "db",
+	clientGroupFlags:   "flags",
+}
+
+// Maps the group_by fields to the attributes of the client list metrics.
+var clientGroupAttributes = map[string]string{
+	clientGroupName:    metadata.A.ClientName,
+	clientGroupLibName: metadata.A.ClientLibName,
+	clientGroupUser:    metadata.A.ClientUser,
+	clientGroupDB:      metadata.A.ClientDb,
+	clientGroupFlags:   metadata.A.ClientFlags,
+}
+
+// The metrics recorded per group of connections.
+var clientListMetrics = map[string]bool{
+	"redis.clients.connections":   true,
+	"redis.clients.query_buffer":  true,
+	"redis.clients.output_memory": true,
+	"redis.clients.idle_time":     true,
+}
+
+// Holds the values of the group_by fields shared by a group of connections.
+type clientGroupKey struct {
+	name, libName, user, db, flags string
+}
+
+// Holds the totals of a group of client connections.
+type clientGroup struct {
+	key         clientGroupKey
+	connections int64
+	queryBuffer int64
+	outputMem   int64
+	idle        int64
+}
+
+// Turns a line of CLIENT LIST into its fields: e.g.
+// "id=3 addr=127.0.0.1:50188 fd=8 name=worker age=10 idle=2 flags=N db=0 qbuf=26 omem=0 user=default"
+func parseClientListLine(line string) (map[string]string, error) {
+	fields := make(map[string]string)
+	for _, pairStr := range strings.Fields(line) {
+		pair := strings.SplitN(pairStr, "=", 2)
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("unexpected client list pair '%s'", pairStr)
+		}
+		fields[pair[0]] = pair[1]
+	}
+	return fields, nil
+}
+
+// groupClients aggregates the CLIENT LIST connections by the groupBy fields.
+// If there are more than maxGroups groups, only the largest ones are kept and
+// the other connections are added up in a single group of clientGroupOther.
+// Unlike INFO, CLIENT LIST lines end with a bare "\n".
+func groupClients(str string, groupBy []string, maxGroups int) ([]*clientGroup, error) {
+	groups := map[clientGroupKey]*clientGroup{}
+	for _, line := range strings.Split(str, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields, err := parseClientListLine(line)
+		if err != nil {
+			return nil, err
+		}
+
+		var key clientGroupKey
+		for _, field := range groupBy {
+			val := fields[clientGroupKeys[field]]
+			switch field {
+			case clientGroupName:
+				key.name = val
+			case clientGroupLibName:
+				key.libName = val
+			case clientGroupUser:
+				key.user = val
+			case clientGroupDB:
+				key.db = val
+			case clientGroupFlags:
+				key.flags = val
+			}
+		}
+		g, ok := groups[key]
+		if !ok {
+			g = &clientGroup{key: key}
+			groups[key] = g
+		}
+		g.connections++
+		// Fields missing from older servers are counted as 0.
+		g.queryBuffer += parseClientListInt(fields["qbuf"])
+		g.outputMem += parseClientListInt(fields["omem"])
+		g.idle += parseClientListInt(fields["idle"])
+	}
+
+	sorted := make([]*clientGroup, 0, len(groups))
+	for _, g := range groups {
+		sorted = append(sorted, g)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].connections != sorted[j].connections {
+			return sorted[i].connections > sorted[j].connections
+		}
+		return fmt.Sprint(sorted[i].key) < fmt.Sprint(sorted[j].key)
+	})
+	if len(sorted) <= maxGroups {
+		return sorted, nil
+	}
+
+	other := &clientGroup{key: otherClientGroupKey(groupBy)}
+	for _, g := range sorted[maxGroups:] {
+		other.connections += g.connections
+		other.queryBuffer += g.queryBuffer
+		other.outputMem += g.outputMem
+		other.idle += g.idle
+	}
+	return append(sorted[:maxGroups], other), nil
+}
+
+func otherClientGroupKey(groupBy []string) clientGroupKey {
+	var key clientGroupKey
+	for _, field := range groupBy {
+		switch field {
+		case clientGroupName:
+			key.name = clientGroupOther
+		case clientGroupLibName:
+			key.libName = clientGroupOther
+		case clientGroupUser:
+			key.user = clientGroupOther
+		case clientGroupDB:
+			key.db = clientGroupOther
+		case clientGroupFlags:
+			key.flags = clientGroupOther
+		}
+	}
+	return key
+}
+
+func parseClientListInt(str string) int64 {
+	val, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return val
+}
+
+// recordClientListMetrics records the connections of CLIENT LIST, grouped as
+// configured, if enabled.
+func (rs *redisScraper) recordClientListMetrics(ts pdata.Timestamp) {
+	if !rs.cfg.ClientList.Enabled {
+		return
+	}
+	str, err := rs.redisSvc.client.retrieveClientList()
+	if err != nil {
+		rs.errs.AddPartial(1, fmt.Errorf("failed to retrieve client list: %w", err))
+		return
+	}
+	groups, err := groupClients(str, rs.cfg.ClientList.GroupBy, rs.cfg.ClientList.MaxGroups)
+	if err != nil {
+		rs.errs.AddPartial(1, fmt.Errorf("failed to parse client list: %w", err))
+		return
+	}
+	for _, g := range groups {
+		k := g.key
+		rs.mb.RecordRedisClientsConnectionsDataPoint(ts, g.connections, k.name, k.libName, k.user, k.db, k.flags)
+		rs.mb.RecordRedisClientsQueryBufferDataPoint(ts, g.queryBuffer, k.name, k.libName, k.user, k.db, k.flags)
+		rs.mb.RecordRedisClientsOutputMemoryDataPoint(ts, g.outputMem, k.name, k.libName, k.user, k.db, k.flags)
+		rs.mb.RecordRedisClientsIdleTimeDataPoint(ts, g.idle, k.name, k.libName, k.user, k.db, k.flags)
+	}
+}
+
+// removeUngroupedClientAttributes drops the attributes of the fields the
+// connections are not grouped by from the emitted client list metrics, as the
+// metrics builder sets every attribute, empty if not grouped by.
+func (rs *redisScraper) removeUngroupedClientAttributes(metrics pdata.MetricSlice) {
+	if !rs.cfg.ClientList.Enabled {
+		return
+	}
+	grouped := make(map[string]bool, len(rs.cfg.ClientList.GroupBy))
+	for _, field := range rs.cfg.ClientList.GroupBy {
+		grouped[field] = true
+	}
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		if !clientListMetrics[m.Name()] {
+			continue
+		}
+		dps := m.Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			attrs := dps.At(j).Attributes()
+			for field, attr := range clientGroupAttributes {
+				if !grouped[field] {
+					attrs.Delete(attr)
+				}
+			}
+		}
+	}
+}
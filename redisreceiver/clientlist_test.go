@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestGroupClients(t *testing.T) {
+	str, err := readFile("client_list")
+	require.NoError(t, err)
+
+	groups, err := groupClients(str, []string{clientGroupName}, 100)
+	require.NoError(t, err)
+	require.Len(t, groups, 3)
+	// largest groups first
+	assert.Equal(t, &clientGroup{key: clientGroupKey{name: "worker"}, connections: 2, queryBuffer: 26, outputMem: 1024, idle: 7}, groups[0])
+	assert.Equal(t, clientGroupKey{name: ""}, groups[1].key)
+	assert.Equal(t, clientGroupKey{name: "scheduler"}, groups[2].key)
+
+	groups, err = groupClients(str, []string{clientGroupLibName, clientGroupUser, clientGroupDB, clientGroupFlags}, 100)
+	require.NoError(t, err)
+	require.Len(t, groups, 3)
+	assert.Equal(t, clientGroupKey{libName: "redis-py", user: "default", db: "0", flags: "N"}, groups[0].key)
+	assert.Equal(t, clientGroupKey{libName: "go-redis", user: "jobs", db: "1", flags: "b"}, groups[2].key)
+
+	groups, err = groupClients(str, nil, 100)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Equal(t, int64(4), groups[0].connections)
+}
+
+func TestGroupClientsMaxGroups(t *testing.T) {
+	str, err := readFile("client_list")
+	require.NoError(t, err)
+
+	groups, err := groupClients(str, []string{clientGroupName, clientGroupUser}, 1)
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, clientGroupKey{name: "worker", user: "default"}, groups[0].key)
+	assert.Equal(t, &clientGroup{
+		key:         clientGroupKey{name: clientGroupOther, user: clientGroupOther},
+		connections: 2,
+		idle:        30,
+	}, groups[1])
+}
+
+func TestGroupClientsCRLF(t *testing.T) {
+	str, err := readFile("client_list")
+	require.NoError(t, err)
+
+	groups, err := groupClients(strings.ReplaceAll(str, "\n", "\r\n"), []string{clientGroupName}, 100)
+	require.NoError(t, err)
+	require.Len(t, groups, 3)
+	assert.Equal(t, clientGroupKey{name: "worker"}, groups[0].key)
+}
+
+// crlfFakeClient delimits INFO lines with "\r\n" like a real server, which
+// still ends CLIENT LIST lines with "\n".
+type crlfFakeClient struct {
+	fakeClient
+}
+
+func (crlfFakeClient) delimiter() string {
+	return "\r\n"
+}
+
+func (c crlfFakeClient) retrieveInfo(sections []string) (string, error) {
+	str, err := c.fakeClient.retrieveInfo(sections)
+	return strings.ReplaceAll(str, "\n", "\r\n"), err
+}
+
+func TestRedisScraperClientListCRLF(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ClientList.Enabled = true
+	runner, err := newRedisScraperWithClient(crlfFakeClient{}, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	m, ok := findMetric(md, "redis.clients.connections")
+	require.True(t, ok)
+	assert.Equal(t, 3, m.Gauge().DataPoints().Len())
+}
+
+func TestParseClientListLineInvalid(t *testing.T) {
+	_, err := parseClientListLine("id=3 addr")
+	require.Error(t, err)
+}
+
+func TestRedisScraperClientList(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	runner, err := newRedisScraperWithClient(newFakeClient(), componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	// opt-in
+	_, ok := findMetric(md, "redis.clients.connections")
+	assert.False(t, ok)
+
+	cfg.ClientList.Enabled = true
+	runner, err = newRedisScraperWithClient(newFakeClient(), componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err = runner.Scrape(context.Background())
+	require.NoError(t, err)
+	for _, name := range []string{"redis.clients.connections", "redis.clients.query_buffer", "redis.clients.output_memory", "redis.clients.idle_time"} {
+		m, ok := findMetric(md, name)
+		require.True(t, ok, name)
+		assert.Equal(t, 3, m.Gauge().DataPoints().Len(), name)
+	}
+	m, _ := findMetric(md, "redis.clients.connections")
+	// only the fields grouped by are attributes
+	assert.Equal(t, map[string]interface{}{"client_name": "worker"}, m.Gauge().DataPoints().At(0).Attributes().AsRaw())
+
+	cfg.ClientList.GroupBy = []string{clientGroupUser, clientGroupDB}
+	runner, err = newRedisScraperWithClient(newFakeClient(), componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err = runner.Scrape(context.Background())
+	require.NoError(t, err)
+	m, _ = findMetric(md, "redis.clients.idle_time")
+	attrs := m.Gauge().DataPoints().At(0).Attributes().AsRaw()
+	assert.Len(t, attrs, 2)
+	assert.Contains(t, attrs, "client_user")
+	assert.Contains(t, attrs, "client_db")
+}
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+)
+
+// Holds a line of the CLUSTER NODES table: e.g.
+// "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master - 0 0 1 connected 0-5460"
+type clusterNode struct {
+	id    string
+	addr  string
+	role  string
+	slots []string
+}
+
+// Turns the CLUSTER NODES table into the nodes that can be scraped. Nodes
+// flagged as failing, without an address or still in handshake are skipped.
+func parseClusterNodes(str string) ([]*clusterNode, error) {
+	var nodes []*clusterNode
+	for _, line := range strings.Split(str, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 8 {
+			return nil, fmt.Errorf("unexpected cluster node line '%s'", line)
+		}
+
+		node := clusterNode{id: fields[0]}
+		skip := false
+		for _, flag := range strings.Split(fields[2], ",") {
+			switch flag {
+			case "master", "slave":
+				node.role = flag
+			case "fail", "noaddr", "handshake":
+				skip = true
+			}
+		}
+		if skip {
+			continue
+		}
+
+		// The address is "ip:port@cport", optionally followed by ",hostname".
+		node.addr = strings.SplitN(fields[1], "@", 2)[0]
+
+		for _, slot := range fields[8:] {
+			// Slots being imported or migrated are listed as "[slot->-id]".
+			if !strings.HasPrefix(slot, "[") {
+				node.slots = append(node.slots, slot)
+			}
+		}
+		nodes = append(nodes, &node)
+	}
+	return nodes, nil
+}
+
+// Finds the nodes of a Redis Cluster by reading CLUSTER NODES from a seed.
+type clusterDiscoverer struct {
+	seed     client
+	endpoint string
+}
+
+func newClusterScraper(seed client, newClient clientFactory, settings component.ReceiverCreateSettings, cfg *Config) (scraperhelper.Scraper, error) {
+	d := &clusterDiscoverer{seed: seed, endpoint: cfg.Endpoint}
+	return newDiscoveryScraper(d, cfg.Cluster.RefreshInterval, newClient, settings, cfg)
+}
+
+// discover reads CLUSTER NODES from the seed, falling back to the nodes found
+// previously so that losing the seed does not stop discovery.
+func (d *clusterDiscoverer) discover(known map[string]client) ([]*redisNode, error) {
+	addr := d.endpoint
+	str, err := d.seed.retrieveClusterNodes()
+	if err != nil {
+		for knownAddr, c := range known {
+			addr = knownAddr
+			if str, err = c.retrieveClusterNodes(); err == nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover cluster nodes: %w", err)
+	}
+
+	clusterNodes, err := parseClusterNodes(str)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*redisNode, 0, len(clusterNodes))
+	for _, cn := range clusterNodes {
+		// A node that has not yet learned its own IP reports it as empty, in
+		// which case it is the node we asked.
+		if strings.HasPrefix(cn.addr, ":") {
+			if host, _, splitErr := net.SplitHostPort(addr); splitErr == nil {
+				cn.addr = host + cn.addr
+			}
+		}
+		nodes = append(nodes, cn.redisNode())
+	}
+	return nodes, nil
+}
+
+func (d *clusterDiscoverer) close() error {
+	return d.seed.close()
+}
+
+// redisNode returns the node to scrape, tagged with its cluster identity.
+func (cn *clusterNode) redisNode() *redisNode {
+	ro := []metadata.ResourceOption{
+		metadata.WithRedisClusterNodeID(cn.id),
+		metadata.WithRedisClusterNodeAddress(cn.addr),
+		metadata.WithRedisClusterNodeRole(cn.role),
+	}
+	if len(cn.slots) > 0 {
+		ro = append(ro, metadata.WithRedisClusterNodeSlots(strings.Join(cn.slots, ",")))
+	}
+	return &redisNode{addr: cn.addr, resourceOptions: ro}
+}
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestParseClusterNodes(t *testing.T) {
+	str, err := readFile("cluster_nodes")
+	require.NoError(t, err)
+	nodes, err := parseClusterNodes(str)
+	require.NoError(t, err)
+	// the failed node is skipped
+	require.Len(t, nodes, 6)
+
+	primary := nodes[5]
+	assert.Equal(t, "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca", primary.id)
+	assert.Equal(t, "127.0.0.1:30001", primary.addr)
+	assert.Equal(t, "master", primary.role)
+	assert.Equal(t, []string{"0-5460"}, primary.slots)
+
+	replica := nodes[0]
+	assert.Equal(t, "127.0.0.1:30004", replica.addr)
+	assert.Equal(t, "slave", replica.role)
+	assert.Empty(t, replica.slots)
+}
+
+func TestParseClusterNodesInvalid(t *testing.T) {
+	_, err := parseClusterNodes("e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001")
+	require.Error(t, err)
+}
+
+// fakeClusterClient serves a configurable CLUSTER NODES table and records
+// whether it was closed.
+type fakeClusterClient struct {
+	fakeClient
+	nodes  string
+	err    error
+	closed bool
+}
+
+func (c *fakeClusterClient) retrieveClusterNodes() (string, error) {
+	return c.nodes, c.err
+}
+
+func (c *fakeClusterClient) close() error {
+	c.closed = true
+	return nil
+}
+
+func TestClusterScraper(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "127.0.0.1:30001"
+	cfg.Mode = modeCluster
+
+	var created []string
+	factory := func(addr string) client {
+		created = append(created, addr)
+		return newFakeClient()
+	}
+	scraper, err := newClusterScraper(newFakeClient(), factory, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	md, err := scraper.Scrape(context.Background())
+	require.NoError(t, err)
+	require.Len(t, created, 6)
+	require.Equal(t, 6, md.ResourceMetrics().Len())
+
+	// nodes are emitted in address order
+	assertAttributesContain(t, map[string]interface{}{
+		"redis.cluster.node.id":      "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca",
+		"redis.cluster.node.address": "127.0.0.1:30001",
+		"redis.cluster.node.role":    "master",
+		"redis.cluster.node.slots":   "0-5460",
+	}, md.ResourceMetrics().At(0).Resource())
+
+	// clients are reused until the next refresh
+	_, err = scraper.Scrape(context.Background())
+	require.NoError(t, err)
+	require.Len(t, created, 6)
+}
+
+func TestClusterScraperDisabledAttributes(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "127.0.0.1:30001"
+	cfg.Mode = modeCluster
+	cfg.ResourceAttributes.RedisClusterNodeSlots.Enabled = false
+
+	factory := func(addr string) client { return newFakeClient() }
+	scraper, err := newClusterScraper(newFakeClient(), factory, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	md, err := scraper.Scrape(context.Background())
+	require.NoError(t, err)
+	attrs := md.ResourceMetrics().At(0).Resource().Attributes().AsRaw()
+	assert.Equal(t, "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca", attrs["redis.cluster.node.id"])
+	assert.NotContains(t, attrs, "redis.cluster.node.slots")
+}
+
+func TestClusterScraperRefresh(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "127.0.0.1:30001"
+	cfg.Mode = modeCluster
+	cfg.Cluster.RefreshInterval = time.Nanosecond
+
+	seed := &fakeClusterClient{nodes: "" +
+		"e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca :30001@31001 myself,master - 0 0 1 connected 0-16383\n" +
+		"07c37dfeb235213a872192d90877d0cd55635b91 127.0.0.1:30004@31004 slave e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 0 1426238317239 4 connected\n"}
+	clients := map[string]*fakeClusterClient{}
+	factory := func(addr string) client {
+		clients[addr] = &fakeClusterClient{nodes: seed.nodes}
+		return clients[addr]
+	}
+	scraper, err := newClusterScraper(seed, factory, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	md, err := scraper.Scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	// the empty address of the seed is resolved to the endpoint host
+	require.Contains(t, clients, "127.0.0.1:30001")
+
+	// the replica leaves the cluster and the seed becomes unreachable
+	seed.err = errors.New("connection refused")
+	clients["127.0.0.1:30004"].err = errors.New("connection refused")
+	clients["127.0.0.1:30001"].nodes = "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master - 0 0 1 connected 0-16383\n"
+	md, err = scraper.Scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	assert.True(t, clients["127.0.0.1:30004"].closed)
+
+	require.NoError(t, scraper.Shutdown(context.Background()))
+	assert.True(t, seed.closed)
+	assert.True(t, clients["127.0.0.1:30001"].closed)
+}
+
+func TestClusterScraperDiscoveryError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Mode = modeCluster
+	seed := &fakeClusterClient{err: errors.New("ERR This instance has cluster support disabled")}
+	scraper, err := newClusterScraper(seed, func(string) client { return newFakeClient() }, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	_, err = scraper.Scrape(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cluster support disabled")
+}
@@ -15,6 +15,11 @@
 package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/config/configtls"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
@@ -30,11 +35,579 @@ type Config struct {
 
 	// TODO allow users to add additional resource key value pairs?
 
+	// Optional ACL username, for Redis 6.0 and later. The default user is
+	// used if it is not set.
+	Username string `mapstructure:"username"`
+
 	// Optional password. Must match the password specified in the
-	// requirepass server configuration option.
+	// requirepass server configuration option, or the password of Username.
 	Password string `mapstructure:"password"`
 
+	// Optional path of a file holding the password instead of Password. It
+	// is read again for every new connection, so that a rotated password is
+	// used without restarting the collector.
+	PasswordFile string `mapstructure:"password_file"`
+
 	TLS configtls.TLSClientSetting `mapstructure:"tls,omitempty"`
 
 	Metrics metadata.MetricsSettings `mapstructure:"metrics"`
+
+	// Which INFO sections are fetched on every scrape.
+	Info InfoSettings `mapstructure:"info"`
+
+	// Settings of the redis.command.latency histograms, which are not described
+	// in metadata.yaml as mdatagen cannot generate histograms.
+	LatencyHistogram LatencyHistogramSettings `mapstructure:"latency_histogram"`
+
+	// Which attributes identifying the scraped server are set on the resource.
+	ResourceAttributes metadata.ResourceAttributesSettings `mapstructure:"resource_attributes"`
+
+	// Mode selects which servers are scraped. "standalone" (the default) scrapes
+	// only Endpoint, "cluster" uses Endpoint as a seed to discover and scrape
+	// every node of a Redis Cluster, "sentinel" asks Redis Sentinel for the
+	// current primaries and replicas and scrapes them.
+	Mode string `mapstructure:"mode"`
+
+	// Settings used when Mode is "cluster".
+	Cluster ClusterSettings `mapstructure:"cluster"`
+
+	// Settings used when Mode is "sentinel".
+	Sentinel SentinelSettings `mapstructure:"sentinel"`
+
+	// Endpoints scraped instead of Endpoint in "standalone" mode. They are
+	// scraped concurrently and each one is emitted as a separate resource.
+	Endpoints []EndpointSettings `mapstructure:"endpoints"`
+
+	// Settings of the opt-in breakdown of client connections from CLIENT LIST.
+	ClientList ClientListSettings `mapstructure:"client_list"`
+
+	// Settings of the opt-in sampling of the largest keys.
+	BigKeys BigKeysSettings `mapstructure:"big_keys"`
+
+	// Settings of the opt-in counting of keys matching patterns.
+	KeyPatterns KeyPatternsSettings `mapstructure:"key_patterns"`
+
+	// Keys whose length is reported, e.g. lists used as job queues.
+	WatchedKeys WatchedKeysSettings `mapstructure:"watched_keys"`
+
+	// Streams whose consumer groups are reported.
+	Streams StreamsSettings `mapstructure:"streams"`
+
+	// Pub/Sub channels whose subscribers are reported.
+	PubSub PubSubSettings `mapstructure:"pubsub"`
+
+	// Settings of the opt-in probe measuring availability and latency from
+	// the collector.
+	Probe ProbeSettings `mapstructure:"probe"`
+
+	// Settings of the opt-in heartbeat measuring the replication lag in
+	// seconds.
+	Heartbeat HeartbeatSettings `mapstructure:"heartbeat"`
+
+	// Settings of the opt-in snapshot of the server configuration.
+	ServerConfig ServerConfigSettings `mapstructure:"server_config"`
+
+	// Metrics mapped from INFO fields the receiver does not know of, e.g.
+	// fields of Redis forks or of new Redis versions.
+	CustomMetrics []CustomMetricSettings `mapstructure:"custom_metrics"`
+
+	// Settings used by the logs receiver, which emits SLOWLOG entries.
+	SlowLog SlowLogSettings `mapstructure:"slowlog"`
+}
+
+// EndpointSettings configures one of several servers scraped by the receiver.
+// Settings that are not set fall back to the receiver-level ones.
+type EndpointSettings struct {
+	confignet.NetAddr `mapstructure:",squash"`
+
+	// Optional password overriding Password.
+	Password string `mapstructure:"password"`
+
+	// Optional TLS settings overriding TLS.
+	TLS *configtls.TLSClientSetting `mapstructure:"tls,omitempty"`
+}
+
+// InfoSettings configures the INFO command run on every scrape.
+type InfoSettings struct {
+	// The sections fetched, e.g. "server", "memory" and "keyspace", or
+	// "everything". By default only the sections holding the values of the
+	// enabled metrics are fetched. The sections read by the enabled features
+	// and the custom metrics are always added. Servers before Redis 7.0 are
+	// sent one INFO command per section.
+	Sections []string `mapstructure:"sections"`
+}
+
+// ClusterSettings configures discovery of Redis Cluster nodes.
+type ClusterSettings struct {
+	// How often CLUSTER NODES is queried to pick up topology changes. Discovery
+	// also runs on the next scrape after any node fails to be scraped.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+// SentinelSettings configures discovery of primaries and replicas through
+// Redis Sentinel.
+type SentinelSettings struct {
+	// Addresses of the sentinels, tried in order until one answers.
+	Addrs []string `mapstructure:"addrs"`
+
+	// Names of the masters to scrape. All masters monitored by the sentinels
+	// are scraped if empty.
+	MasterNames []string `mapstructure:"master_names"`
+
+	// Optional password used to authenticate with the sentinels, which may
+	// differ from the one used for the Redis servers.
+	Password string `mapstructure:"password"`
+
+	// How often the sentinels are asked for the current primaries and
+	// replicas. Discovery also runs on the next scrape after any server fails
+	// to be scraped, so failovers are followed quickly.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+const (
+	modeStandalone = "standalone"
+	modeCluster    = "cluster"
+	modeSentinel   = "sentinel"
+)
+
+// LatencyHistogramSettings configures the collection of per-command latency
+// histograms with LATENCY HISTOGRAM.
+type LatencyHistogramSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// ClientListSettings configures how the connections listed by CLIENT LIST are
+// grouped into metrics.
+type ClientListSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// The CLIENT LIST fields connections are grouped by, among "name",
+	// "lib_name", "user", "db" and "flags". Without any, all connections are
+	// counted in a single group.
+	GroupBy []string `mapstructure:"group_by"`
+
+	// The maximum number of groups reported. Connections of the smaller groups
+	// beyond it are counted in a group whose fields are all "(other groups)",
+	// so that clients with random names cannot create unbounded series.
+	MaxGroups int `mapstructure:"max_groups"`
+}
+
+// BigKeysSettings configures the sampling of keys with SCAN and MEMORY USAGE
+// to report the largest ones.
+type BigKeysSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// The fraction of the keys of each database sampled on every scrape. The
+	// scan resumes where it stopped, so the whole keyspace is covered in about
+	// 1/SampleRatio scrapes.
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+
+	// The COUNT hint passed to each SCAN.
+	ScanCount int `mapstructure:"scan_count"`
+
+	// The number of largest keys reported per database and key type.
+	TopN int `mapstructure:"top_n"`
+
+	// The maximum time spent sampling on every scrape. Sampling resumes on the
+	// next scrape once it is spent.
+	TimeBudget time.Duration `mapstructure:"time_budget"`
+}
+
+// KeyPatternsSettings configures the walk of the keyspace counting the keys
+// and memory matching each pattern.
+type KeyPatternsSettings struct {
+	// The patterns keys are matched against. The walk only runs if there is
+	// at least one.
+	Patterns []KeyPatternSettings `mapstructure:"patterns"`
+
+	// The maximum number of keys of each database scanned on every scrape.
+	KeysPerScrape int `mapstructure:"keys_per_scrape"`
+
+	// The COUNT hint passed to each SCAN.
+	ScanCount int `mapstructure:"scan_count"`
+
+	// The maximum time spent walking on every scrape.
+	TimeBudget time.Duration `mapstructure:"time_budget"`
+}
+
+// KeyPatternSettings configures a pattern, either a Redis glob-style pattern
+// such as "session:*" or a regular expression.
+type KeyPatternSettings struct {
+	// The value of the pattern attribute, defaults to the glob or regex.
+	Name  string `mapstructure:"name"`
+	Glob  string `mapstructure:"glob"`
+	Regex string `mapstructure:"regex"`
+}
+
+// WatchedKeysSettings configures the keys whose length is reported on every
+// scrape.
+type WatchedKeysSettings struct {
+	Keys []WatchedKeySettings `mapstructure:"keys"`
+
+	// The maximum number of keys a glob-style pattern is expanded into.
+	MaxMatches int `mapstructure:"max_matches"`
+
+	// The maximum number of keys scanned for each pattern on every scrape. A
+	// pattern is expanded again once its scan completes; until then the keys
+	// of the previous scan are reported.
+	KeysPerScrape int `mapstructure:"keys_per_scrape"`
+
+	// The COUNT hint passed to each SCAN.
+	ScanCount int `mapstructure:"scan_count"`
+
+	// The maximum time spent expanding patterns on every scrape.
+	TimeBudget time.Duration `mapstructure:"time_budget"`
+}
+
+// WatchedKeySettings configures a watched key.
+type WatchedKeySettings struct {
+	// The key, or a Redis glob-style pattern such as "jobs:*" matched with
+	// SCAN a batch at a time across scrapes.
+	Key string `mapstructure:"key"`
+
+	// The database holding the key.
+	DB int `mapstructure:"db"`
+}
+
+// StreamsSettings configures which streams the state of the consumer groups
+// is reported for.
+type StreamsSettings struct {
+	Keys []StreamKeySettings `mapstructure:"keys"`
+
+	// Whether the streams of every database are discovered with SCAN TYPE
+	// stream, in addition to Keys. A database is scanned again once its scan
+	// completes; until then the streams of the previous scan are reported.
+	Discovery bool `mapstructure:"discovery"`
+
+	// The maximum number of streams discovered per database.
+	MaxStreams int `mapstructure:"max_streams"`
+
+	// The maximum number of keys scanned for each database on every scrape.
+	KeysPerScrape int `mapstructure:"keys_per_scrape"`
+
+	// The COUNT hint passed to each SCAN.
+	ScanCount int `mapstructure:"scan_count"`
+
+	// The maximum time spent discovering streams on every scrape.
+	TimeBudget time.Duration `mapstructure:"time_budget"`
+}
+
+// StreamKeySettings configures a stream.
+type StreamKeySettings struct {
+	Key string `mapstructure:"key"`
+
+	// The database holding the stream.
+	DB int `mapstructure:"db"`
+}
+
+// PubSubSettings configures which Pub/Sub channels the number of subscribers
+// is reported for.
+type PubSubSettings struct {
+	// Channel names, or Redis glob-style patterns such as "notify.*" matched
+	// with PUBSUB CHANNELS on every scrape.
+	Channels []string `mapstructure:"channels"`
+
+	// Sharded channel names or patterns, only reported by Redis 7.0 and
+	// later.
+	ShardChannels []string `mapstructure:"shard_channels"`
+
+	// The maximum number of channels a glob-style pattern is expanded into.
+	MaxChannels int `mapstructure:"max_channels"`
+}
+
+// ProbeSettings configures the probe sent on every scrape.
+type ProbeSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Optional key written with SET then read back with GET on every scrape,
+	// in addition to PING. Replicas, which cannot be written to, only get
+	// PING.
+	CanaryKey string `mapstructure:"canary_key"`
+
+	// The expiration of the canary key, so that it does not outlive the
+	// collector.
+	CanaryTTL time.Duration `mapstructure:"canary_ttl"`
+}
+
+// HeartbeatSettings configures the heartbeat written to primaries and read
+// from their replicas on every scrape.
+type HeartbeatSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// The key the heartbeat is written to, which must not be used by the
+	// application.
+	Key string `mapstructure:"key"`
+
+	// The expiration of the heartbeat key, so that it does not outlive the
+	// collector. Replicas lagging by more than the TTL report no lag.
+	TTL time.Duration `mapstructure:"ttl"`
+}
+
+// ServerConfigSettings configures which server configuration parameters are
+// reported from CONFIG GET.
+type ServerConfigSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// The parameters reported, or glob-style patterns of them.
+	Parameters []string `mapstructure:"parameters"`
+
+	// How often CONFIG GET is run. The last values are reported on every
+	// scrape in between.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+// CustomMetricSettings maps INFO fields to a metric.
+type CustomMetricSettings struct {
+	// The INFO key, e.g. "mem_clients_normal".
+	Key string `mapstructure:"key"`
+
+	// A regular expression matched against every INFO key instead of Key,
+	// e.g. "^cmdstat_(?P<command>.+)$". Its named groups become attributes.
+	KeyRegex string `mapstructure:"key_regex"`
+
+	// The INFO section holding the key, fetched in addition to the sections
+	// of the enabled metrics or the configured ones. Every section is fetched
+	// if it is not set and Info.Sections is not set either.
+	Section string `mapstructure:"section"`
+
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	Unit        string `mapstructure:"unit"`
+
+	// "gauge" (the default) or "sum". Sums are cumulative.
+	Type      string `mapstructure:"type"`
+	Monotonic bool   `mapstructure:"monotonic"`
+
+	// "double" (the default) or "int".
+	ValueType string `mapstructure:"value_type"`
+
+	// The sub-field holding the value of values made of sub-fields, e.g.
+	// "calls" for "calls=1,usec=2".
+	ValueField string `mapstructure:"value_field"`
+
+	// Other sub-fields of the value that become attributes.
+	AttributeFields []string `mapstructure:"attribute_fields"`
+}
+
+// SlowLogSettings configures how SLOWLOG entries are collected as logs.
+type SlowLogSettings struct {
+	// The maximum number of entries read by each SLOWLOG GET. Entries added
+	// faster than this between two polls are lost.
+	MaxEntries int64 `mapstructure:"max_entries"`
+
+	// Whether the arguments of slow commands are replaced with "?", keeping
+	// only the command name, so that keys and values are not logged.
+	RedactArgs bool `mapstructure:"redact_args"`
+
+	// Whether the entries the server retained before the collector started
+	// are emitted by the first poll. They are skipped by default, as they
+	// were likely emitted before the collector restarted.
+	EmitBacklog bool `mapstructure:"emit_backlog"`
+}
+
+// Validate checks the receiver configuration is valid.
+func (cfg *Config) Validate() error {
+	if cfg.Password != "" && cfg.PasswordFile != "" {
+		return errors.New("password and password_file cannot both be set")
+	}
+
+	if len(cfg.Endpoints) > 0 {
+		if cfg.Mode != "" && cfg.Mode != modeStandalone {
+			return fmt.Errorf("endpoints can only be used in %q mode", modeStandalone)
+		}
+		if cfg.Endpoint != "" {
+			return errors.New("endpoint and endpoints cannot both be set")
+		}
+		seen := make(map[string]bool, len(cfg.Endpoints))
+		for _, endpoint := range cfg.Endpoints {
+			if endpoint.Endpoint == "" {
+				return errors.New("endpoints must not contain an empty endpoint")
+			}
+			if seen[endpoint.Endpoint] {
+				return fmt.Errorf("duplicate endpoint %q", endpoint.Endpoint)
+			}
+			seen[endpoint.Endpoint] = true
+		}
+	}
+
+	if len(cfg.Info.Sections) > 0 {
+		hasServer := false
+		for _, section := range cfg.Info.Sections {
+			section = strings.ToLower(section)
+			switch {
+			case section == "server" || infoSectionGroups[section]:
+				hasServer = true
+			case !infoSectionNames[section]:
+				return fmt.Errorf("unsupported info section %q", section)
+			}
+		}
+		if !hasServer {
+			return errors.New(`info sections must include "server", "default", "all" or "everything"`)
+		}
+	}
+
+	if cfg.ClientList.Enabled {
+		if cfg.ClientList.MaxGroups <= 0 {
+			return fmt.Errorf("client_list max_groups must be positive, got %d", cfg.ClientList.MaxGroups)
+		}
+		for _, field := range cfg.ClientList.GroupBy {
+			if _, ok := clientGroupKeys[field]; !ok {
+				return fmt.Errorf("unsupported client_list group_by field %q, must be one of %q, %q, %q, %q or %q",
+					field, clientGroupName, clientGroupLibName, clientGroupUser, clientGroupDB, clientGroupFlags)
+			}
+		}
+	}
+
+	if cfg.BigKeys.Enabled {
+		if cfg.BigKeys.SampleRatio <= 0 || cfg.BigKeys.SampleRatio > 1 {
+			return fmt.Errorf("big_keys sample_ratio must be in (0, 1], got %v", cfg.BigKeys.SampleRatio)
+		}
+		if cfg.BigKeys.ScanCount <= 0 {
+			return fmt.Errorf("big_keys scan_count must be positive, got %d", cfg.BigKeys.ScanCount)
+		}
+		if cfg.BigKeys.TopN <= 0 {
+			return fmt.Errorf("big_keys top_n must be positive, got %d", cfg.BigKeys.TopN)
+		}
+		if cfg.BigKeys.TimeBudget <= 0 {
+			return fmt.Errorf("big_keys time_budget must be positive, got %v", cfg.BigKeys.TimeBudget)
+		}
+	}
+
+	if len(cfg.KeyPatterns.Patterns) > 0 {
+		patterns, err := compileKeyPatterns(cfg.KeyPatterns.Patterns)
+		if err != nil {
+			return err
+		}
+		seen := make(map[string]bool, len(patterns))
+		for _, p := range patterns {
+			if seen[p.name] {
+				return fmt.Errorf("duplicate key pattern %q", p.name)
+			}
+			seen[p.name] = true
+		}
+		if cfg.KeyPatterns.KeysPerScrape <= 0 {
+			return fmt.Errorf("key_patterns keys_per_scrape must be positive, got %d", cfg.KeyPatterns.KeysPerScrape)
+		}
+		if cfg.KeyPatterns.ScanCount <= 0 {
+			return fmt.Errorf("key_patterns scan_count must be positive, got %d", cfg.KeyPatterns.ScanCount)
+		}
+		if cfg.KeyPatterns.TimeBudget <= 0 {
+			return fmt.Errorf("key_patterns time_budget must be positive, got %v", cfg.KeyPatterns.TimeBudget)
+		}
+	}
+
+	if len(cfg.WatchedKeys.Keys) > 0 {
+		for _, watched := range cfg.WatchedKeys.Keys {
+			if watched.Key == "" {
+				return errors.New("watched_keys must not contain an empty key")
+			}
+			if watched.DB < 0 {
+				return fmt.Errorf("watched key %q has a negative db %d", watched.Key, watched.DB)
+			}
+		}
+		if cfg.WatchedKeys.MaxMatches <= 0 {
+			return fmt.Errorf("watched_keys max_matches must be positive, got %d", cfg.WatchedKeys.MaxMatches)
+		}
+		if cfg.WatchedKeys.KeysPerScrape <= 0 {
+			return fmt.Errorf("watched_keys keys_per_scrape must be positive, got %d", cfg.WatchedKeys.KeysPerScrape)
+		}
+		if cfg.WatchedKeys.ScanCount <= 0 {
+			return fmt.Errorf("watched_keys scan_count must be positive, got %d", cfg.WatchedKeys.ScanCount)
+		}
+		if cfg.WatchedKeys.TimeBudget <= 0 {
+			return fmt.Errorf("watched_keys time_budget must be positive, got %v", cfg.WatchedKeys.TimeBudget)
+		}
+	}
+
+	for _, stream := range cfg.Streams.Keys {
+		if stream.Key == "" {
+			return errors.New("streams must not contain an empty key")
+		}
+		if stream.DB < 0 {
+			return fmt.Errorf("stream %q has a negative db %d", stream.Key, stream.DB)
+		}
+	}
+	if cfg.Streams.Discovery {
+		if cfg.Streams.MaxStreams <= 0 {
+			return fmt.Errorf("streams max_streams must be positive, got %d", cfg.Streams.MaxStreams)
+		}
+		if cfg.Streams.KeysPerScrape <= 0 {
+			return fmt.Errorf("streams keys_per_scrape must be positive, got %d", cfg.Streams.KeysPerScrape)
+		}
+		if cfg.Streams.ScanCount <= 0 {
+			return fmt.Errorf("streams scan_count must be positive, got %d", cfg.Streams.ScanCount)
+		}
+		if cfg.Streams.TimeBudget <= 0 {
+			return fmt.Errorf("streams time_budget must be positive, got %v", cfg.Streams.TimeBudget)
+		}
+	}
+
+	if len(cfg.PubSub.Channels) > 0 || len(cfg.PubSub.ShardChannels) > 0 {
+		for _, channels := range [][]string{cfg.PubSub.Channels, cfg.PubSub.ShardChannels} {
+			for _, channel := range channels {
+				if channel == "" {
+					return errors.New("pubsub channels must not contain an empty channel")
+				}
+			}
+		}
+		if cfg.PubSub.MaxChannels <= 0 {
+			return fmt.Errorf("pubsub max_channels must be positive, got %d", cfg.PubSub.MaxChannels)
+		}
+	}
+
+	if cfg.Probe.Enabled && cfg.Probe.CanaryKey != "" {
+		if cfg.Mode == modeCluster {
+			return fmt.Errorf("probe canary_key cannot be used in %q mode", modeCluster)
+		}
+		if cfg.Probe.CanaryTTL <= 0 {
+			return fmt.Errorf("probe canary_ttl must be positive, got %v", cfg.Probe.CanaryTTL)
+		}
+	}
+
+	if cfg.Heartbeat.Enabled {
+		if cfg.Mode == modeCluster {
+			return fmt.Errorf("heartbeat cannot be used in %q mode", modeCluster)
+		}
+		if cfg.Heartbeat.Key == "" {
+			return errors.New("heartbeat key must not be empty")
+		}
+		if cfg.Heartbeat.TTL <= 0 {
+			return fmt.Errorf("heartbeat ttl must be positive, got %v", cfg.Heartbeat.TTL)
+		}
+	}
+
+	if cfg.ServerConfig.Enabled {
+		if len(cfg.ServerConfig.Parameters) == 0 {
+			return errors.New("server_config parameters must not be empty")
+		}
+		if cfg.ServerConfig.RefreshInterval <= 0 {
+			return fmt.Errorf("server_config refresh_interval must be positive, got %v", cfg.ServerConfig.RefreshInterval)
+		}
+	}
+
+	if _, err := compileCustomMetrics(cfg.CustomMetrics); err != nil {
+		return err
+	}
+
+	if cfg.SlowLog.MaxEntries <= 0 {
+		return fmt.Errorf("slowlog max_entries must be positive, got %d", cfg.SlowLog.MaxEntries)
+	}
+
+	switch cfg.Mode {
+	case "", modeStandalone:
+	case modeCluster:
+		if cfg.Cluster.RefreshInterval <= 0 {
+			return fmt.Errorf("cluster refresh_interval must be positive, got %v", cfg.Cluster.RefreshInterval)
+		}
+	case modeSentinel:
+		if len(cfg.Sentinel.Addrs) == 0 {
+			return errors.New("sentinel addrs must not be empty")
+		}
+		if cfg.Sentinel.RefreshInterval <= 0 {
+			return fmt.Errorf("sentinel refresh_interval must be positive, got %v", cfg.Sentinel.RefreshInterval)
+		}
+	default:
+		return fmt.Errorf("unsupported mode %q, must be one of %q, %q or %q", cfg.Mode, modeStandalone, modeCluster, modeSentinel)
+	}
+	return nil
 }
@@ -0,0 +1,412 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/config/confignet"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *Config)
+		errMsg string
+	}{
+		{
+			name:   "default",
+			modify: func(cfg *Config) {},
+		},
+		{
+			name:   "cluster",
+			modify: func(cfg *Config) { cfg.Mode = modeCluster },
+		},
+		{
+			name: "cluster without refresh interval",
+			modify: func(cfg *Config) {
+				cfg.Mode = modeCluster
+				cfg.Cluster.RefreshInterval = 0
+			},
+			errMsg: "cluster refresh_interval must be positive",
+		},
+		{
+			name: "sentinel",
+			modify: func(cfg *Config) {
+				cfg.Mode = modeSentinel
+				cfg.Sentinel.Addrs = []string{"localhost:26379"}
+			},
+		},
+		{
+			name:   "sentinel without addrs",
+			modify: func(cfg *Config) { cfg.Mode = modeSentinel },
+			errMsg: "sentinel addrs must not be empty",
+		},
+		{
+			name: "endpoints",
+			modify: func(cfg *Config) {
+				cfg.Endpoints = []EndpointSettings{
+					{NetAddr: confignet.NetAddr{Endpoint: "redis-a:6379"}},
+					{NetAddr: confignet.NetAddr{Endpoint: "redis-b:6379"}},
+				}
+			},
+		},
+		{
+			name: "endpoints with endpoint",
+			modify: func(cfg *Config) {
+				cfg.Endpoint = "localhost:6379"
+				cfg.Endpoints = []EndpointSettings{{NetAddr: confignet.NetAddr{Endpoint: "redis-a:6379"}}}
+			},
+			errMsg: "endpoint and endpoints cannot both be set",
+		},
+		{
+			name: "duplicate endpoints",
+			modify: func(cfg *Config) {
+				cfg.Endpoints = []EndpointSettings{
+					{NetAddr: confignet.NetAddr{Endpoint: "redis-a:6379"}},
+					{NetAddr: confignet.NetAddr{Endpoint: "redis-a:6379"}},
+				}
+			},
+			errMsg: `duplicate endpoint "redis-a:6379"`,
+		},
+		{
+			name: "endpoints in cluster mode",
+			modify: func(cfg *Config) {
+				cfg.Mode = modeCluster
+				cfg.Endpoints = []EndpointSettings{{NetAddr: confignet.NetAddr{Endpoint: "redis-a:6379"}}}
+			},
+			errMsg: `endpoints can only be used in "standalone" mode`,
+		},
+		{
+			name:   "unknown mode",
+			modify: func(cfg *Config) { cfg.Mode = "replicated" },
+			errMsg: `unsupported mode "replicated"`,
+		},
+		{
+			name: "client list",
+			modify: func(cfg *Config) {
+				cfg.ClientList.Enabled = true
+				cfg.ClientList.GroupBy = []string{"name", "lib_name", "user", "db", "flags"}
+			},
+		},
+		{
+			name: "client list with unknown field",
+			modify: func(cfg *Config) {
+				cfg.ClientList.Enabled = true
+				cfg.ClientList.GroupBy = []string{"addr"}
+			},
+			errMsg: `unsupported client_list group_by field "addr"`,
+		},
+		{
+			name: "client list without max groups",
+			modify: func(cfg *Config) {
+				cfg.ClientList.Enabled = true
+				cfg.ClientList.MaxGroups = 0
+			},
+			errMsg: "client_list max_groups must be positive",
+		},
+		{
+			name: "big keys with invalid sample ratio",
+			modify: func(cfg *Config) {
+				cfg.BigKeys.Enabled = true
+				cfg.BigKeys.SampleRatio = 1.5
+			},
+			errMsg: "big_keys sample_ratio must be in (0, 1]",
+		},
+		{
+			name: "big keys without time budget",
+			modify: func(cfg *Config) {
+				cfg.BigKeys.Enabled = true
+				cfg.BigKeys.TimeBudget = 0
+			},
+			errMsg: "big_keys time_budget must be positive",
+		},
+		{
+			name: "key patterns",
+			modify: func(cfg *Config) {
+				cfg.KeyPatterns.Patterns = []KeyPatternSettings{{Glob: "session:*"}, {Name: "users", Regex: "^user:"}}
+			},
+		},
+		{
+			name: "duplicate key patterns",
+			modify: func(cfg *Config) {
+				cfg.KeyPatterns.Patterns = []KeyPatternSettings{{Glob: "session:*"}, {Name: "session:*", Regex: "^session:"}}
+			},
+			errMsg: `duplicate key pattern "session:*"`,
+		},
+		{
+			name: "invalid key pattern",
+			modify: func(cfg *Config) {
+				cfg.KeyPatterns.Patterns = []KeyPatternSettings{{Regex: "("}}
+			},
+			errMsg: "invalid key pattern",
+		},
+		{
+			name: "watched keys",
+			modify: func(cfg *Config) {
+				cfg.WatchedKeys.Keys = []WatchedKeySettings{{Key: "jobs:*"}, {Key: "events", DB: 1}}
+			},
+		},
+		{
+			name: "watched keys with empty key",
+			modify: func(cfg *Config) {
+				cfg.WatchedKeys.Keys = []WatchedKeySettings{{DB: 1}}
+			},
+			errMsg: "watched_keys must not contain an empty key",
+		},
+		{
+			name: "watched keys without max matches",
+			modify: func(cfg *Config) {
+				cfg.WatchedKeys.Keys = []WatchedKeySettings{{Key: "jobs:*"}}
+				cfg.WatchedKeys.MaxMatches = 0
+			},
+			errMsg: "watched_keys max_matches must be positive, got 0",
+		},
+		{
+			name: "watched keys without keys per scrape",
+			modify: func(cfg *Config) {
+				cfg.WatchedKeys.Keys = []WatchedKeySettings{{Key: "jobs:*"}}
+				cfg.WatchedKeys.KeysPerScrape = 0
+			},
+			errMsg: "watched_keys keys_per_scrape must be positive, got 0",
+		},
+		{
+			name: "watched keys without scan count",
+			modify: func(cfg *Config) {
+				cfg.WatchedKeys.Keys = []WatchedKeySettings{{Key: "jobs:*"}}
+				cfg.WatchedKeys.ScanCount = 0
+			},
+			errMsg: "watched_keys scan_count must be positive, got 0",
+		},
+		{
+			name: "watched keys without time budget",
+			modify: func(cfg *Config) {
+				cfg.WatchedKeys.Keys = []WatchedKeySettings{{Key: "jobs:*"}}
+				cfg.WatchedKeys.TimeBudget = 0
+			},
+			errMsg: "watched_keys time_budget must be positive, got 0s",
+		},
+		{
+			name: "streams",
+			modify: func(cfg *Config) {
+				cfg.Streams.Keys = []StreamKeySettings{{Key: "events", DB: 1}}
+				cfg.Streams.Discovery = true
+			},
+		},
+		{
+			name: "streams with empty key",
+			modify: func(cfg *Config) {
+				cfg.Streams.Keys = []StreamKeySettings{{DB: 1}}
+			},
+			errMsg: "streams must not contain an empty key",
+		},
+		{
+			name: "stream discovery without max streams",
+			modify: func(cfg *Config) {
+				cfg.Streams.Discovery = true
+				cfg.Streams.MaxStreams = 0
+			},
+			errMsg: "streams max_streams must be positive, got 0",
+		},
+		{
+			name: "stream discovery without keys per scrape",
+			modify: func(cfg *Config) {
+				cfg.Streams.Discovery = true
+				cfg.Streams.KeysPerScrape = 0
+			},
+			errMsg: "streams keys_per_scrape must be positive, got 0",
+		},
+		{
+			name: "stream discovery without scan count",
+			modify: func(cfg *Config) {
+				cfg.Streams.Discovery = true
+				cfg.Streams.ScanCount = 0
+			},
+			errMsg: "streams scan_count must be positive, got 0",
+		},
+		{
+			name: "stream discovery without time budget",
+			modify: func(cfg *Config) {
+				cfg.Streams.Discovery = true
+				cfg.Streams.TimeBudget = 0
+			},
+			errMsg: "streams time_budget must be positive, got 0s",
+		},
+		{
+			name: "pubsub channels",
+			modify: func(cfg *Config) {
+				cfg.PubSub.Channels = []string{"notify.*", "audit"}
+			},
+		},
+		{
+			name: "pubsub channels with empty channel",
+			modify: func(cfg *Config) {
+				cfg.PubSub.ShardChannels = []string{""}
+			},
+			errMsg: "pubsub channels must not contain an empty channel",
+		},
+		{
+			name: "pubsub channels without max channels",
+			modify: func(cfg *Config) {
+				cfg.PubSub.Channels = []string{"notify.*"}
+				cfg.PubSub.MaxChannels = 0
+			},
+			errMsg: "pubsub max_channels must be positive, got 0",
+		},
+		{
+			name: "info sections",
+			modify: func(cfg *Config) {
+				cfg.Info.Sections = []string{"Server", "memory", "keyspace"}
+			},
+		},
+		{
+			name: "unsupported info section",
+			modify: func(cfg *Config) {
+				cfg.Info.Sections = []string{"server", "memroy"}
+			},
+			errMsg: `unsupported info section "memroy"`,
+		},
+		{
+			name: "info sections without server",
+			modify: func(cfg *Config) {
+				cfg.Info.Sections = []string{"memory"}
+			},
+			errMsg: `info sections must include "server", "default", "all" or "everything"`,
+		},
+		{
+			name: "custom metrics",
+			modify: func(cfg *Config) {
+				cfg.CustomMetrics = []CustomMetricSettings{{Name: "redis.memory.startup", Key: "used_memory_startup"}}
+			},
+		},
+		{
+			name: "invalid custom metric",
+			modify: func(cfg *Config) {
+				cfg.CustomMetrics = []CustomMetricSettings{{Name: "redis.memory.startup"}}
+			},
+			errMsg: `custom metric "redis.memory.startup" must have exactly one of key or key_regex`,
+		},
+		{
+			name: "server config",
+			modify: func(cfg *Config) {
+				cfg.ServerConfig.Enabled = true
+			},
+		},
+		{
+			name: "server config without parameters",
+			modify: func(cfg *Config) {
+				cfg.ServerConfig.Enabled = true
+				cfg.ServerConfig.Parameters = nil
+			},
+			errMsg: "server_config parameters must not be empty",
+		},
+		{
+			name: "server config without refresh interval",
+			modify: func(cfg *Config) {
+				cfg.ServerConfig.Enabled = true
+				cfg.ServerConfig.RefreshInterval = 0
+			},
+			errMsg: "server_config refresh_interval must be positive, got 0s",
+		},
+		{
+			name: "username and password file",
+			modify: func(cfg *Config) {
+				cfg.Username = "collector"
+				cfg.PasswordFile = "/etc/redis/password"
+			},
+		},
+		{
+			name: "password and password file",
+			modify: func(cfg *Config) {
+				cfg.Password = "s3cret"
+				cfg.PasswordFile = "/etc/redis/password"
+			},
+			errMsg: "password and password_file cannot both be set",
+		},
+		{
+			name: "probe with canary key",
+			modify: func(cfg *Config) {
+				cfg.Probe.Enabled = true
+				cfg.Probe.CanaryKey = "otel:canary"
+			},
+		},
+		{
+			name: "probe with canary key in cluster mode",
+			modify: func(cfg *Config) {
+				cfg.Mode = modeCluster
+				cfg.Probe.Enabled = true
+				cfg.Probe.CanaryKey = "otel:canary"
+			},
+			errMsg: `probe canary_key cannot be used in "cluster" mode`,
+		},
+		{
+			name: "probe with canary key without ttl",
+			modify: func(cfg *Config) {
+				cfg.Probe.Enabled = true
+				cfg.Probe.CanaryKey = "otel:canary"
+				cfg.Probe.CanaryTTL = 0
+			},
+			errMsg: "probe canary_ttl must be positive, got 0s",
+		},
+		{
+			name:   "heartbeat",
+			modify: func(cfg *Config) { cfg.Heartbeat.Enabled = true },
+		},
+		{
+			name: "heartbeat in cluster mode",
+			modify: func(cfg *Config) {
+				cfg.Mode = modeCluster
+				cfg.Heartbeat.Enabled = true
+			},
+			errMsg: `heartbeat cannot be used in "cluster" mode`,
+		},
+		{
+			name: "heartbeat without key",
+			modify: func(cfg *Config) {
+				cfg.Heartbeat.Enabled = true
+				cfg.Heartbeat.Key = ""
+			},
+			errMsg: "heartbeat key must not be empty",
+		},
+		{
+			name: "heartbeat without ttl",
+			modify: func(cfg *Config) {
+				cfg.Heartbeat.Enabled = true
+				cfg.Heartbeat.TTL = 0
+			},
+			errMsg: "heartbeat ttl must be positive, got 0s",
+		},
+		{
+			name:   "slowlog without max entries",
+			modify: func(cfg *Config) { cfg.SlowLog.MaxEntries = 0 },
+			errMsg: "slowlog max_entries must be positive",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			test.modify(cfg)
+			err := cfg.Validate()
+			if test.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), test.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.uber.org/zap"
+)
+
+// Types and value types of custom metrics.
+const (
+	customMetricGauge  = "gauge"
+	customMetricSum    = "sum"
+	customMetricInt    = "int"
+	customMetricDouble = "double"
+)
+
+// A configured custom metric, with its key regex compiled.
+type customMetric struct {
+	settings CustomMetricSettings
+	re       *regexp.Regexp // nil when matching settings.Key exactly
+}
+
+// compileCustomMetrics checks the configured custom metrics and compiles their
+// key regexes.
+func compileCustomMetrics(settings []CustomMetricSettings) ([]*customMetric, error) {
+	metrics := make([]*customMetric, 0, len(settings))
+	seen := make(map[string]bool, len(settings))
+	for _, s := range settings {
+		if s.Name == "" {
+			return nil, errors.New("custom_metrics must not contain a metric without name")
+		}
+		if seen[s.Name] {
+			return nil, fmt.Errorf("duplicate custom metric %q", s.Name)
+		}
+		seen[s.Name] = true
+		if (s.Key == "") == (s.KeyRegex == "") {
+			return nil, fmt.Errorf("custom metric %q must have exactly one of key or key_regex", s.Name)
+		}
+		switch s.Type {
+		case "", customMetricGauge:
+			if s.Monotonic {
+				return nil, fmt.Errorf("custom metric %q must be a sum to be monotonic", s.Name)
+			}
+		case customMetricSum:
+		default:
+			return nil, fmt.Errorf("custom metric %q has unsupported type %q, must be %q or %q",
+				s.Name, s.Type, customMetricGauge, customMetricSum)
+		}
+		switch s.ValueType {
+		case "", customMetricInt, customMetricDouble:
+		default:
+			return nil, fmt.Errorf("custom metric %q has unsupported value_type %q, must be %q or %q",
+				s.Name, s.ValueType, customMetricInt, customMetricDouble)
+		}
+		if len(s.AttributeFields) > 0 && s.ValueField == "" {
+			return nil, fmt.Errorf("custom metric %q must have a value_field to have attribute_fields", s.Name)
+		}
+		if s.Section != "" && !infoSectionNames[strings.ToLower(s.Section)] {
+			return nil, fmt.Errorf("custom metric %q has unsupported info section %q", s.Name, s.Section)
+		}
+
+		m := &customMetric{settings: s}
+		if s.KeyRegex != "" {
+			var err error
+			if m.re, err = regexp.Compile(s.KeyRegex); err != nil {
+				return nil, fmt.Errorf("invalid key_regex of custom metric %q: %w", s.Name, err)
+			}
+		}
+		metrics = append(metrics, m)
+	}
+	return metrics, nil
+}
+
+// parseInfoFields turns an INFO value made of sub-fields, e.g.
+// "keys=1,expires=2,avg_ttl=3", into a map.
+func parseInfoFields(val string) map[string]string {
+	fields := map[string]string{}
+	for _, field := range strings.Split(val, ",") {
+		if pair := strings.SplitN(field, "=", 2); len(pair) == 2 {
+			fields[pair[0]] = pair[1]
+		}
+	}
+	return fields
+}
+
+// A value of a custom metric and its attributes.
+type customMetricValue struct {
+	intVal    int64
+	doubleVal float64
+	attrs     map[string]string
+}
+
+// values returns the values of the INFO keys matching the metric, in the
+// order of keys. The named groups of the key regex, and the configured
+// attribute fields, become attributes. Values that cannot be parsed are
+// skipped and added to errs.
+func (m *customMetric) values(inf info, keys []string, errs *scrapererror.ScrapeErrors) []customMetricValue {
+	var vals []customMetricValue
+	add := func(key string, attrs map[string]string) {
+		str := inf[key]
+		if m.settings.ValueField != "" {
+			fields := parseInfoFields(str)
+			var ok bool
+			if str, ok = fields[m.settings.ValueField]; !ok {
+				return
+			}
+			for _, name := range m.settings.AttributeFields {
+				if field, ok := fields[name]; ok {
+					attrs[name] = field
+				}
+			}
+		}
+
+		val := customMetricValue{attrs: attrs}
+		var err error
+		if m.settings.ValueType == customMetricInt {
+			if val.intVal, err = strconv.ParseInt(str, 10, 64); err != nil {
+				errs.AddPartial(1, fmt.Errorf("failed to parse info int val %s: %w", key, err))
+				return
+			}
+		} else if val.doubleVal, err = strconv.ParseFloat(str, 64); err != nil {
+			errs.AddPartial(1, fmt.Errorf("failed to parse info float val %s: %w", key, err))
+			return
+		}
+		vals = append(vals, val)
+	}
+
+	if m.re == nil {
+		if _, ok := inf[m.settings.Key]; ok {
+			add(m.settings.Key, map[string]string{})
+		}
+		return vals
+	}
+	for _, key := range keys {
+		match := m.re.FindStringSubmatch(key)
+		if match == nil {
+			continue
+		}
+		attrs := map[string]string{}
+		for i, name := range m.re.SubexpNames() {
+			if name != "" {
+				attrs[name] = match[i]
+			}
+		}
+		add(key, attrs)
+	}
+	return vals
+}
+
+// recordCustomMetrics appends the custom metrics mapped from INFO fields by
+// the configuration. Metrics without any value are not appended.
+func (rs *redisScraper) recordCustomMetrics(ts pdata.Timestamp, inf info, metrics pdata.MetricSlice) {
+	if len(rs.cfg.CustomMetrics) == 0 {
+		return
+	}
+	if rs.customMetrics == nil {
+		var err error
+		if rs.customMetrics, err = compileCustomMetrics(rs.cfg.CustomMetrics); err != nil {
+			// Already checked by Config.Validate.
+			rs.settings.Logger.Warn("failed to compile custom metrics", zap.Error(err))
+			return
+		}
+	}
+
+	keys := make([]string, 0, len(inf))
+	for key := range inf {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, cm := range rs.customMetrics {
+		vals := cm.values(inf, keys, &rs.errs)
+		if len(vals) == 0 {
+			continue
+		}
+
+		m := metrics.AppendEmpty()
+		m.SetName(cm.settings.Name)
+		m.SetDescription(cm.settings.Description)
+		m.SetUnit(cm.settings.Unit)
+		var dps pdata.NumberDataPointSlice
+		if cm.settings.Type == customMetricSum {
+			m.SetDataType(pdata.MetricDataTypeSum)
+			m.Sum().SetIsMonotonic(cm.settings.Monotonic)
+			m.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+			dps = m.Sum().DataPoints()
+		} else {
+			m.SetDataType(pdata.MetricDataTypeGauge)
+			dps = m.Gauge().DataPoints()
+		}
+		dps.EnsureCapacity(len(vals))
+		for _, v := range vals {
+			dp := dps.AppendEmpty()
+			dp.SetStartTimestamp(rs.startTime)
+			dp.SetTimestamp(ts)
+			if cm.settings.ValueType == customMetricInt {
+				dp.SetIntVal(v.intVal)
+			} else {
+				dp.SetDoubleVal(v.doubleVal)
+			}
+			for name, val := range v.attrs {
+				dp.Attributes().InsertString(name, val)
+			}
+			dp.Attributes().Sort()
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+func TestParseInfoFields(t *testing.T) {
+	assert.Equal(t, map[string]string{"keys": "1", "expires": "2", "avg_ttl": "3"},
+		parseInfoFields("keys=1,expires=2,avg_ttl=3"))
+	assert.Equal(t, map[string]string{}, parseInfoFields("standalone"))
+}
+
+func TestCompileCustomMetrics(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings CustomMetricSettings
+		errMsg   string
+	}{
+		{
+			name:     "key",
+			settings: CustomMetricSettings{Name: "redis.memory.startup", Key: "used_memory_startup"},
+		},
+		{
+			name: "key regex",
+			settings: CustomMetricSettings{Name: "redis.command.custom_calls", KeyRegex: "^cmdstat_(?P<command>.+)$",
+				Type: "sum", Monotonic: true, ValueType: "int", ValueField: "calls"},
+		},
+		{
+			name:     "without name",
+			settings: CustomMetricSettings{Key: "used_memory_startup"},
+			errMsg:   "custom_metrics must not contain a metric without name",
+		},
+		{
+			name:     "key and key regex",
+			settings: CustomMetricSettings{Name: "m", Key: "used_memory_startup", KeyRegex: "^used_"},
+			errMsg:   `custom metric "m" must have exactly one of key or key_regex`,
+		},
+		{
+			name:     "without key",
+			settings: CustomMetricSettings{Name: "m"},
+			errMsg:   `custom metric "m" must have exactly one of key or key_regex`,
+		},
+		{
+			name:     "invalid key regex",
+			settings: CustomMetricSettings{Name: "m", KeyRegex: "("},
+			errMsg:   `invalid key_regex of custom metric "m"`,
+		},
+		{
+			name:     "unsupported type",
+			settings: CustomMetricSettings{Name: "m", Key: "k", Type: "histogram"},
+			errMsg:   `custom metric "m" has unsupported type "histogram", must be "gauge" or "sum"`,
+		},
+		{
+			name:     "monotonic gauge",
+			settings: CustomMetricSettings{Name: "m", Key: "k", Monotonic: true},
+			errMsg:   `custom metric "m" must be a sum to be monotonic`,
+		},
+		{
+			name:     "unsupported value type",
+			settings: CustomMetricSettings{Name: "m", Key: "k", ValueType: "string"},
+			errMsg:   `custom metric "m" has unsupported value_type "string", must be "int" or "double"`,
+		},
+		{
+			name:     "attribute fields without value field",
+			settings: CustomMetricSettings{Name: "m", Key: "k", AttributeFields: []string{"state"}},
+			errMsg:   `custom metric "m" must have a value_field to have attribute_fields`,
+		},
+		{
+			name:     "unsupported section",
+			settings: CustomMetricSettings{Name: "m", Key: "k", Section: "memroy"},
+			errMsg:   `custom metric "m" has unsupported info section "memroy"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := compileCustomMetrics([]CustomMetricSettings{test.settings})
+			if test.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errMsg)
+			}
+		})
+	}
+
+	_, err := compileCustomMetrics([]CustomMetricSettings{{Name: "m", Key: "k"}, {Name: "m", Key: "l"}})
+	assert.EqualError(t, err, `duplicate custom metric "m"`)
+}
+
+func TestRedisScraperCustomMetrics(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.CustomMetrics = []CustomMetricSettings{
+		{Name: "redis.memory.startup", Key: "used_memory_startup", Unit: "By", ValueType: "int"},
+		{Name: "redis.command.custom_calls", KeyRegex: "^cmdstat_(?P<command>.+)$", Type: "sum", Monotonic: true,
+			ValueType: "int", ValueField: "calls"},
+		{Name: "redis.db.custom_keys", KeyRegex: `^db(?P<db>\d+)$`, ValueField: "keys", AttributeFields: []string{"avg_ttl"}},
+		{Name: "redis.missing", Key: "missing_field"},
+		{Name: "redis.not_a_number", Key: "redis_mode"},
+	}
+	runner, err := newRedisScraperWithClient(newFakeClient(), componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Contains(t, err.Error(), "failed to parse info float val redis_mode")
+
+	m, ok := findMetric(md, "redis.memory.startup")
+	require.True(t, ok)
+	assert.Equal(t, "By", m.Unit())
+	require.Equal(t, pdata.MetricDataTypeGauge, m.DataType())
+	require.Equal(t, 1, m.Gauge().DataPoints().Len())
+	assert.Equal(t, int64(791264), m.Gauge().DataPoints().At(0).IntVal())
+
+	m, ok = findMetric(md, "redis.command.custom_calls")
+	require.True(t, ok)
+	require.Equal(t, pdata.MetricDataTypeSum, m.DataType())
+	assert.True(t, m.Sum().IsMonotonic())
+	calls := map[string]int64{}
+	for i := 0; i < m.Sum().DataPoints().Len(); i++ {
+		dp := m.Sum().DataPoints().At(i)
+		command, _ := dp.Attributes().Get("command")
+		calls[command.StringVal()] = dp.IntVal()
+	}
+	assert.Equal(t, map[string]int64{"get": 2, "set": 1}, calls)
+
+	m, ok = findMetric(md, "redis.db.custom_keys")
+	require.True(t, ok)
+	require.Equal(t, 2, m.Gauge().DataPoints().Len())
+	dp := m.Gauge().DataPoints().At(0)
+	assert.Equal(t, 1.0, dp.DoubleVal())
+	assert.Equal(t, map[string]interface{}{"db": "0", "avg_ttl": "3"}, dp.Attributes().AsRaw())
+
+	_, ok = findMetric(md, "redis.missing")
+	assert.False(t, ok)
+	_, ok = findMetric(md, "redis.not_a_number")
+	assert.False(t, ok)
+}
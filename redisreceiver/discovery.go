@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+)
+
+// Describes a server found through discovery.
+type redisNode struct {
+	addr string
+	// resource attributes identifying the server
+	resourceOptions []metadata.ResourceOption
+}
+
+// Finds the servers to scrape, e.g. the nodes of a Redis Cluster.
+type discoverer interface {
+	// returns the current set of servers. known holds the clients of the
+	// servers found previously, to be used if the usual source is unavailable.
+	discover(known map[string]client) ([]*redisNode, error)
+	// releases the connections held by the discoverer
+	close() error
+}
+
+// Scrapes every server found by a discoverer with its own redisScraper,
+// emitting one ResourceMetrics per server.
+type discoveryScraper struct {
+	discoverer      discoverer
+	refreshInterval time.Duration
+	newClient       clientFactory
+	settings        component.ReceiverCreateSettings
+	cfg             *Config
+	nodes           map[string]*nodeScraper // keyed by node address
+	lastRefresh     time.Time
+	stale           bool
+}
+
+type nodeScraper struct {
+	node    *redisNode
+	scraper *redisScraper
+}
+
+func newDiscoveryScraper(
+	d discoverer,
+	refreshInterval time.Duration,
+	newClient clientFactory,
+	settings component.ReceiverCreateSettings,
+	cfg *Config,
+) (scraperhelper.Scraper, error) {
+	ds := &discoveryScraper{
+		discoverer:      d,
+		refreshInterval: refreshInterval,
+		newClient:       newClient,
+		settings:        settings,
+		cfg:             cfg,
+		nodes:           map[string]*nodeScraper{},
+	}
+	return scraperhelper.NewScraper(typeStr, ds.Scrape, scraperhelper.WithShutdown(ds.shutdown))
+}
+
+// Scrape refreshes the set of servers when it is due, or when a server was down
+// last time, then scrapes every known server. Servers that fail are reported
+// as a partial scrape error so the others are still emitted, along with
+// whatever the failed server reported, e.g. redis.up.
+func (ds *discoveryScraper) Scrape(ctx context.Context) (pdata.Metrics, error) {
+	if ds.stale || time.Since(ds.lastRefresh) >= ds.refreshInterval {
+		if err := ds.refresh(ctx); err != nil {
+			if len(ds.nodes) == 0 {
+				return pdata.Metrics{}, err
+			}
+			ds.settings.Logger.Warn("failed to refresh discovered nodes, scraping previously discovered nodes", zap.Error(err))
+		}
+	}
+
+	addrs := make([]string, 0, len(ds.nodes))
+	for addr := range ds.nodes {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	// Servers are scraped concurrently so that a slow one does not delay the
+	// others past the collection interval.
+	results := make([]pdata.Metrics, len(addrs))
+	scrapeErrs := make([]error, len(addrs))
+	var wg sync.WaitGroup
+	for i, addr := range addrs {
+		wg.Add(1)
+		go func(i int, ns *nodeScraper) {
+			defer wg.Done()
+			results[i], scrapeErrs[i] = ns.scraper.Scrape(ctx)
+		}(i, ds.nodes[addr])
+	}
+	wg.Wait()
+
+	pdm := pdata.NewMetrics()
+	var errs scrapererror.ScrapeErrors
+	for i, addr := range addrs {
+		if scrapeErrs[i] != nil {
+			failed := 1
+			var partialErr scrapererror.PartialScrapeError
+			if errors.As(scrapeErrs[i], &partialErr) {
+				failed = partialErr.Failed
+			}
+			errs.AddPartial(failed, fmt.Errorf("failed to scrape node %s: %w", addr, scrapeErrs[i]))
+			// Only a node that is down hints at a change of topology.
+			if !ds.nodes[addr].scraper.available {
+				ds.stale = true
+			}
+			if !scrapererror.IsPartialScrapeError(scrapeErrs[i]) {
+				continue
+			}
+		}
+		rms := results[i].ResourceMetrics()
+		for j := 0; j < rms.Len(); j++ {
+			ds.cfg.ResourceAttributes.SetResourceAttributes(rms.At(j).Resource(), ds.nodes[addr].node.resourceOptions...)
+		}
+		rms.MoveAndAppendTo(pdm.ResourceMetrics())
+	}
+	return pdm, errs.Combine()
+}
+
+// refresh re-runs discovery, creating scrapers for new servers and closing the
+// scrapers of servers that are gone.
+func (ds *discoveryScraper) refresh(ctx context.Context) error {
+	known := make(map[string]client, len(ds.nodes))
+	for addr, ns := range ds.nodes {
+		known[addr] = ns.scraper.redisSvc.client
+	}
+	nodes, err := ds.discoverer.discover(known)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]*nodeScraper, len(nodes))
+	for _, node := range nodes {
+		if ns, ok := ds.nodes[node.addr]; ok {
+			ns.node = node
+			current[node.addr] = ns
+			delete(ds.nodes, node.addr)
+			continue
+		}
+		scraper := newNodeScraper(ds.newClient(node.addr), node.addr, ds.settings, ds.cfg)
+		scraper.newReplicaClient = ds.newClient
+		current[node.addr] = &nodeScraper{node: node, scraper: scraper}
+	}
+	// Whatever is left was not discovered again.
+	for addr, ns := range ds.nodes {
+		if err := ns.scraper.shutdown(ctx); err != nil {
+			ds.settings.Logger.Warn("failed to close node client", zap.String("addr", addr), zap.Error(err))
+		}
+	}
+
+	ds.nodes = current
+	ds.lastRefresh = time.Now()
+	ds.stale = false
+	return nil
+}
+
+// shutdown closes the discoverer and every node client.
+func (ds *discoveryScraper) shutdown(ctx context.Context) error {
+	errs := []error{ds.discoverer.close()}
+	for _, ns := range ds.nodes {
+		errs = append(errs, ns.scraper.shutdown(ctx))
+	}
+	return multierr.Combine(errs...)
+}
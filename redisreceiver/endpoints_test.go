@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+type failingClient struct {
+	fakeClient
+}
+
+func (failingClient) retrieveInfo([]string) (string, error) {
+	return "", errors.New("connection refused")
+}
+
+func TestEndpointsScraper(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	addrs := []string{"redis-b:6379", "redis-a:6379", "redis-c:6379"}
+	factory := func(addr string) client {
+		if addr == "redis-c:6379" {
+			return failingClient{}
+		}
+		return newFakeClient()
+	}
+	scraper, err := newEndpointsScraper(addrs, factory, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	md, err := scraper.Scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Contains(t, err.Error(), "redis-c:6379")
+
+	rms := md.ResourceMetrics()
+	require.Equal(t, 3, rms.Len())
+	assert.Equal(t, "redis-a:6379", rms.At(0).Resource().Attributes().AsRaw()["redis.endpoint"])
+	assert.Equal(t, "redis-b:6379", rms.At(1).Resource().Attributes().AsRaw()["redis.endpoint"])
+	assert.Equal(t, rms.At(0).InstrumentationLibraryMetrics().At(0).Metrics().Len(),
+		rms.At(1).InstrumentationLibraryMetrics().At(0).Metrics().Len())
+
+	// The failed server is only reported as down.
+	assert.Equal(t, "redis-c:6379", rms.At(2).Resource().Attributes().AsRaw()["redis.endpoint"])
+	metrics := rms.At(2).InstrumentationLibraryMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	assert.Equal(t, "redis.up", metrics.At(0).Name())
+	assert.EqualValues(t, 0, metrics.At(0).Gauge().DataPoints().At(0).IntVal())
+	assert.Equal(t, "redis.scrape.error", metrics.At(1).Name())
+}
+
+func TestEndpointsScraperPartialNode(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	factory := func(addr string) client {
+		if addr == "redis-b:6379" {
+			return sectionFailingClient{failed: []string{"latencystats", "errorstats"}}
+		}
+		return newFakeClient()
+	}
+	scraper, err := newEndpointsScraper([]string{"redis-a:6379", "redis-b:6379"}, factory,
+		componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	md, err := scraper.Scrape(context.Background())
+	require.Error(t, err)
+	var partialErr scrapererror.PartialScrapeError
+	require.True(t, errors.As(err, &partialErr))
+	// The failures are counted as reported by the node.
+	assert.Equal(t, 2, partialErr.Failed)
+	assert.Contains(t, err.Error(), "redis-b:6379")
+	assert.Equal(t, 2, md.ResourceMetrics().Len())
+}
+
+func TestNewEndpointOptions(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Password = "shared"
+
+	opts, err := newEndpointOptions(cfg, EndpointSettings{NetAddr: confignet.NetAddr{Endpoint: "redis-a:6379"}})
+	require.NoError(t, err)
+	assert.Equal(t, "redis-a:6379", opts.Addr)
+	assert.Equal(t, "shared", opts.Password)
+	assert.Equal(t, "tcp", opts.Network)
+	assert.Nil(t, opts.TLSConfig)
+
+	opts, err = newEndpointOptions(cfg, EndpointSettings{
+		NetAddr:  confignet.NetAddr{Endpoint: "redis-b:6379"},
+		Password: "override",
+		TLS:      &configtls.TLSClientSetting{ServerName: "redis-b"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "override", opts.Password)
+	require.NotNil(t, opts.TLSConfig)
+	assert.Equal(t, "redis-b", opts.TLSConfig.ServerName)
+}
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Holds fields returned by the errorstats section of the INFO command: e.g.
+// "errorstat_WRONGTYPE:count=2"
+type errorstat struct {
+	prefix string
+	count  int64
+}
+
+// Turns an errorstat value (the part after the colon e.g. "count=2") into an
+// errorstat struct
+func parseErrorstatString(prefix string, str string) (*errorstat, error) {
+	es := errorstat{prefix: prefix}
+	for _, pairStr := range strings.Split(str, ",") {
+		pair := strings.Split(pairStr, "=")
+		if len(pair) != 2 {
+			return nil, fmt.Errorf(
+				"unexpected errorstat pair '%s'",
+				pairStr,
+			)
+		}
+		if pair[0] == "count" {
+			val, err := strconv.ParseInt(pair[1], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			es.count = val
+		}
+	}
+	return &es, nil
+}
@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseErrorstatString(t *testing.T) {
+	es, err := parseErrorstatString("OOM", "count=7")
+	require.NoError(t, err)
+	require.Equal(t, &errorstat{prefix: "OOM", count: 7}, es)
+
+	_, err = parseErrorstatString("OOM", "count")
+	require.Error(t, err)
+	_, err = parseErrorstatString("OOM", "count=x")
+	require.Error(t, err)
+}
@@ -37,7 +37,8 @@ func NewFactory() component.ReceiverFactory {
 	return component.NewReceiverFactory(
 		typeStr,
 		createDefaultConfig,
-		component.WithMetricsReceiver(createMetricsReceiver))
+		component.WithMetricsReceiver(createMetricsReceiver),
+		component.WithLogsReceiver(createLogsReceiver))
 }
 
 func createDefaultConfig() config.Receiver {
@@ -52,6 +53,62 @@ func createDefaultConfig() config.Receiver {
 		},
 		ScraperControllerSettings: scs,
 		Metrics:                   metadata.DefaultMetricsSettings(),
+		ResourceAttributes:        metadata.DefaultResourceAttributesSettings(),
+		Mode:                      modeStandalone,
+		Cluster: ClusterSettings{
+			RefreshInterval: time.Minute,
+		},
+		Sentinel: SentinelSettings{
+			RefreshInterval: 10 * time.Second,
+		},
+		ClientList: ClientListSettings{
+			GroupBy:   []string{clientGroupName},
+			MaxGroups: 100,
+		},
+		BigKeys: BigKeysSettings{
+			SampleRatio: 0.01,
+			ScanCount:   100,
+			TopN:        10,
+			TimeBudget:  100 * time.Millisecond,
+		},
+		KeyPatterns: KeyPatternsSettings{
+			KeysPerScrape: 1000,
+			ScanCount:     100,
+			TimeBudget:    100 * time.Millisecond,
+		},
+		WatchedKeys: WatchedKeysSettings{
+			MaxMatches:    100,
+			KeysPerScrape: 10000,
+			ScanCount:     1000,
+			TimeBudget:    100 * time.Millisecond,
+		},
+		Streams: StreamsSettings{
+			MaxStreams:    100,
+			KeysPerScrape: 10000,
+			ScanCount:     1000,
+			TimeBudget:    100 * time.Millisecond,
+		},
+		PubSub: PubSubSettings{
+			MaxChannels: 100,
+		},
+		Probe: ProbeSettings{
+			CanaryTTL: time.Minute,
+		},
+		Heartbeat: HeartbeatSettings{
+			Key: "otel:redisreceiver:heartbeat",
+			TTL: time.Hour,
+		},
+		ServerConfig: ServerConfigSettings{
+			Parameters: []string{
+				"maxclients", "maxmemory", "timeout", "appendonly", "save", "io-threads", "hz",
+				"repl-backlog-size", "databases",
+			},
+			RefreshInterval: 5 * time.Minute,
+		},
+		SlowLog: SlowLogSettings{
+			MaxEntries: 128,
+			RedactArgs: true,
+		},
 	}
 }
 
@@ -70,3 +127,12 @@ func createMetricsReceiver(
 
 	return scraperhelper.NewScraperControllerReceiver(&oCfg.ScraperControllerSettings, set, consumer, scraperhelper.AddScraper(scrp))
 }
+
+func createLogsReceiver(
+	_ context.Context,
+	set component.ReceiverCreateSettings,
+	cfg config.Receiver,
+	consumer consumer.Logs,
+) (component.LogsReceiver, error) {
+	return newSlowLogReceiver(cfg.(*Config), set, consumer)
+}
@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// recordHeartbeatMetrics writes the time to the heartbeat key of a primary,
+// then reads the key from every replica listed in the replication section of
+// INFO. The lag of a replica is the time since the oldest heartbeat written by
+// the collector that it has not applied, or 0 if it holds the latest one,
+// measured with the collector's clock only, so that it is not skewed by the
+// clocks of the servers.
+func (rs *redisScraper) recordHeartbeatMetrics(ts pdata.Timestamp, inf info) {
+	settings := rs.cfg.Heartbeat
+	if !settings.Enabled || inf["role"] != "master" || rs.newReplicaClient == nil {
+		return
+	}
+	written := time.Now()
+	if err := rs.redisSvc.client.writeHeartbeat(settings.Key, written, settings.TTL); err != nil {
+		// The replicas are still compared to the previous heartbeats.
+		rs.errs.AddPartial(1, fmt.Errorf("failed to write heartbeat: %w", err))
+	} else {
+		rs.heartbeats = append(rs.heartbeats, written)
+	}
+	// Replicas holding a heartbeat older than the TTL read none.
+	for len(rs.heartbeats) > 1 && time.Since(rs.heartbeats[0]) > settings.TTL {
+		rs.heartbeats = rs.heartbeats[1:]
+	}
+
+	replicas := map[string]client{}
+	for i := 0; ; i++ {
+		str, ok := inf["slave"+strconv.Itoa(i)]
+		if !ok {
+			break
+		}
+		// Replicas that fail to parse are already reported by
+		// recordReplicationMetrics.
+		r, err := parseReplicaString(str)
+		if err != nil {
+			continue
+		}
+		c, ok := rs.replicaClients[r.addr]
+		if !ok {
+			c = rs.newReplicaClient(r.addr)
+		}
+		replicas[r.addr] = c
+
+		heartbeat, ok, err := c.readHeartbeat(settings.Key)
+		if err != nil {
+			rs.errs.AddPartial(1, fmt.Errorf("failed to read heartbeat of replica %s: %w", r.addr, err))
+			continue
+		}
+		if !ok {
+			rs.settings.Logger.Debug("replica has no heartbeat yet", zap.String("endpoint", rs.endpoint),
+				zap.String("replica", r.addr))
+			continue
+		}
+		rs.mb.RecordRedisReplicationHeartbeatLagDataPoint(ts, heartbeatLag(rs.heartbeats, heartbeat, time.Now()).Seconds(), r.addr)
+	}
+
+	// Close the clients of the replicas that are gone.
+	for addr, c := range rs.replicaClients {
+		if _, ok := replicas[addr]; !ok {
+			if err := c.close(); err != nil {
+				rs.settings.Logger.Warn("failed to close replica client", zap.String("replica", addr), zap.Error(err))
+			}
+		}
+	}
+	rs.replicaClients = replicas
+}
+
+// heartbeatLag returns the time since the oldest of the heartbeats written,
+// oldest first, that is newer than the heartbeat a replica holds, or 0 if the
+// replica holds the latest one.
+func heartbeatLag(written []time.Time, heartbeat time.Time, now time.Time) time.Duration {
+	for _, t := range written {
+		if t.After(heartbeat) {
+			return now.Sub(t)
+		}
+	}
+	return 0
+}
+
+// closeReplicaClients closes the connections to the replicas opened by the
+// heartbeat.
+func (rs *redisScraper) closeReplicaClients() error {
+	var errs error
+	for _, c := range rs.replicaClients {
+		errs = multierr.Append(errs, c.close())
+	}
+	rs.replicaClients = nil
+	return errs
+}
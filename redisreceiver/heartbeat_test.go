@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+// heartbeatPrimary records the heartbeats written to it, unless it fails the
+// write with err.
+type heartbeatPrimary struct {
+	*replacingFakeClient
+	key        string
+	heartbeats []time.Time
+	ttl        time.Duration
+	err        error
+}
+
+func (c *heartbeatPrimary) writeHeartbeat(key string, t time.Time, ttl time.Duration) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.key, c.ttl = key, ttl
+	c.heartbeats = append(c.heartbeats, t)
+	return nil
+}
+
+// heartbeatReplica holds the heartbeat of its primary written behind writes
+// before the latest one.
+type heartbeatReplica struct {
+	fakeClient
+	primary *heartbeatPrimary
+	behind  int
+	missing bool
+	err     error
+	closed  bool
+}
+
+func (c *heartbeatReplica) readHeartbeat(key string) (time.Time, bool, error) {
+	i := len(c.primary.heartbeats) - 1 - c.behind
+	if c.err != nil || c.missing || key != c.primary.key || i < 0 {
+		return time.Time{}, false, c.err
+	}
+	return c.primary.heartbeats[i], true, nil
+}
+
+func (c *heartbeatReplica) close() error {
+	c.closed = true
+	return nil
+}
+
+func heartbeatLags(md pdata.Metrics) map[string]float64 {
+	m, ok := findMetric(md, "redis.replication.heartbeat_lag")
+	if !ok {
+		return nil
+	}
+	lags := map[string]float64{}
+	for i := 0; i < m.Gauge().DataPoints().Len(); i++ {
+		dp := m.Gauge().DataPoints().At(i)
+		replica, _ := dp.Attributes().Get("replica")
+		lags[replica.StringVal()] = dp.DoubleVal()
+	}
+	return lags
+}
+
+func TestRedisScraperHeartbeat(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Heartbeat.Enabled = true
+	primary := &heartbeatPrimary{replacingFakeClient: newReplacingFakeClient("connected_slaves:0\n",
+		"connected_slaves:4\n"+
+			"slave0:ip=10.0.0.2,port=6379,state=online,offset=0,lag=0\n"+
+			"slave1:ip=10.0.0.3,port=6379,state=online,offset=0,lag=5\n"+
+			"slave2:ip=10.0.0.4,port=6379,state=wait_bgsave,offset=0,lag=0\n"+
+			"slave3:ip=10.0.0.5,port=6379,state=online,offset=0,lag=0\n")}
+	replicas := map[string]*heartbeatReplica{
+		"10.0.0.2:6379": {primary: primary},
+		"10.0.0.3:6379": {primary: primary, behind: 1},
+		"10.0.0.4:6379": {primary: primary, missing: true},
+		"10.0.0.5:6379": {primary: primary, err: errors.New("LOADING Redis is loading the dataset in memory")},
+	}
+	rs := newNodeScraper(primary, "localhost:6379", componenttest.NewNopReceiverCreateSettings(), cfg)
+	rs.newReplicaClient = func(addr string) client {
+		return replicas[addr]
+	}
+	// The previous heartbeat was written a minute ago.
+	previous := time.Now().Add(-time.Minute)
+	primary.heartbeats = []time.Time{previous}
+	rs.heartbeats = []time.Time{previous}
+
+	md, err := rs.Scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Contains(t, err.Error(), "10.0.0.5:6379")
+	assert.Equal(t, "otel:redisreceiver:heartbeat", primary.key)
+	assert.Equal(t, time.Hour, primary.ttl)
+
+	// A replica holding the latest heartbeat has no lag, and one a single
+	// heartbeat behind is only behind since the latest one was written, not
+	// since the previous one.
+	lags := heartbeatLags(md)
+	require.Len(t, lags, 2)
+	assert.Equal(t, 0.0, lags["10.0.0.2:6379"])
+	assert.Greater(t, lags["10.0.0.3:6379"], 0.0)
+	assert.Less(t, lags["10.0.0.3:6379"], 1.0)
+
+	// Replicas that are gone are disconnected.
+	primary.replacingFakeClient = newReplacingFakeClient("connected_slaves:0\n",
+		"connected_slaves:1\nslave0:ip=10.0.0.2,port=6379,state=online,offset=0,lag=0\n")
+	_, err = rs.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.False(t, replicas["10.0.0.2:6379"].closed)
+	assert.True(t, replicas["10.0.0.3:6379"].closed)
+	assert.True(t, replicas["10.0.0.4:6379"].closed)
+	assert.True(t, replicas["10.0.0.5:6379"].closed)
+
+	require.NoError(t, rs.shutdown(context.Background()))
+	assert.True(t, replicas["10.0.0.2:6379"].closed)
+}
+
+func TestHeartbeatLag(t *testing.T) {
+	now := time.Now()
+	written := []time.Time{now.Add(-3 * time.Minute), now.Add(-2 * time.Minute), now.Add(-time.Minute)}
+	assert.Equal(t, time.Duration(0), heartbeatLag(written, written[2], now))
+	assert.Equal(t, time.Minute, heartbeatLag(written, written[1], now))
+	assert.Equal(t, 2*time.Minute, heartbeatLag(written, written[0], now))
+	// heartbeats written before the collector started are not known
+	assert.Equal(t, 3*time.Minute, heartbeatLag(written, now.Add(-time.Hour), now))
+}
+
+func TestRedisScraperHeartbeatWriteFailure(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Heartbeat.Enabled = true
+	primary := &heartbeatPrimary{replacingFakeClient: newReplacingFakeClient("connected_slaves:0\n",
+		"connected_slaves:1\nslave0:ip=10.0.0.2,port=6379,state=online,offset=0,lag=0\n")}
+	rs := newNodeScraper(primary, "localhost:6379", componenttest.NewNopReceiverCreateSettings(), cfg)
+	rs.newReplicaClient = func(string) client {
+		return &heartbeatReplica{primary: primary}
+	}
+
+	_, err := rs.Scrape(context.Background())
+	require.NoError(t, err)
+
+	// The replica still holds the previous heartbeat.
+	primary.err = errors.New("READONLY You can't write against a read only replica.")
+	md, err := rs.Scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	assert.Contains(t, err.Error(), "failed to write heartbeat")
+	assert.Len(t, heartbeatLags(md), 1)
+}
+
+func TestRedisScraperHeartbeatSkipped(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		role    string
+	}{
+		{name: "disabled", role: "master"},
+		{name: "replica", enabled: true, role: "slave"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Heartbeat.Enabled = test.enabled
+			primary := &heartbeatPrimary{replacingFakeClient: newReplacingFakeClient(
+				"role:master", "role:"+test.role,
+				"connected_slaves:0\n", "connected_slaves:1\nslave0:ip=10.0.0.2,port=6379,state=online,offset=0,lag=0\n")}
+			rs := newNodeScraper(primary, "localhost:6379", componenttest.NewNopReceiverCreateSettings(), cfg)
+			rs.newReplicaClient = func(string) client {
+				return &heartbeatReplica{primary: primary}
+			}
+
+			md, err := rs.Scrape(context.Background())
+			require.NoError(t, err)
+			assert.Empty(t, primary.key)
+			assert.Nil(t, heartbeatLags(md))
+		})
+	}
+}
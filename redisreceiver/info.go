@@ -16,7 +16,9 @@ package redisreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"errors"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -35,3 +37,23 @@ func (i info) getUptimeInSeconds() (time.Duration, error) {
 	}
 	return time.Duration(sec) * time.Second, nil
 }
+
+// keyspaceDBs returns the databases listed in the keyspace section, e.g. 0
+// and 5 for "db0:keys=1,..." and "db5:keys=2,...", in ascending order. Only
+// databases holding keys are listed, and there can be more than 16 of them
+// if the databases configuration directive is raised.
+func (i info) keyspaceDBs() []int {
+	var dbs []int
+	for key := range i {
+		if !strings.HasPrefix(key, "db") {
+			continue
+		}
+		db, err := strconv.Atoi(key[len("db"):])
+		if err != nil || db < 0 || strconv.Itoa(db) != key[len("db"):] {
+			continue
+		}
+		dbs = append(dbs, db)
+	}
+	sort.Ints(dbs)
+	return dbs
+}
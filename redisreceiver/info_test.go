@@ -22,9 +22,22 @@ import (
 )
 
 func TestGetUptime(t *testing.T) {
-	svc := newRedisSvc(newFakeClient())
+	svc := newRedisSvc(newFakeClient(), nil)
 	info, _ := svc.info()
 	uptime, err := info.getUptimeInSeconds()
 	require.Nil(t, err)
 	require.Equal(t, time.Duration(104946000000000), uptime)
 }
+
+func TestKeyspaceDBs(t *testing.T) {
+	inf := info{
+		"db0":          "keys=1,expires=0,avg_ttl=0",
+		"db5":          "keys=1,expires=0,avg_ttl=0",
+		"db20":         "keys=1,expires=0,avg_ttl=0",
+		"db05":         "keys=1,expires=0,avg_ttl=0",
+		"dbfilename":   "dump.rdb",
+		"uptime_in_ms": "1",
+	}
+	require.Equal(t, []int{0, 5, 20}, inf.keyspaceDBs())
+	require.Empty(t, info{}.keyspaceDBs())
+}
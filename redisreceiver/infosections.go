@@ -0,0 +1,145 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"sort"
+	"strings"
+)
+
+// The INFO sections the receiver knows of, and the special sections selecting
+// several of them.
+var (
+	infoSectionNames = map[string]bool{
+		"server": true, "clients": true, "memory": true, "persistence": true, "stats": true,
+		"replication": true, "cpu": true, "modules": true, "commandstats": true, "latencystats": true,
+		"errorstats": true, "cluster": true, "keyspace": true,
+	}
+	infoSectionGroups = map[string]bool{"default": true, "all": true, "everything": true}
+	// The sections not part of "default".
+	infoNonDefaultSections = map[string]bool{"commandstats": true, "latencystats": true, "modules": true}
+)
+
+// infoSections returns the INFO sections fetched on every scrape: the
+// configured ones, or else only those holding the values of the enabled
+// metrics, features and custom metrics. The server section is always fetched
+// as it holds the uptime and version.
+func infoSections(cfg *Config) []string {
+	if len(cfg.Info.Sections) > 0 {
+		return withFeatureSections(cfg, cfg.Info.Sections)
+	}
+	customSections := map[string]bool{}
+	for _, cm := range cfg.CustomMetrics {
+		if cm.Section == "" {
+			return []string{"everything"}
+		}
+		customSections[strings.ToLower(cm.Section)] = true
+	}
+
+	ms := cfg.Metrics
+	ras := cfg.ResourceAttributes
+	candidates := []struct {
+		name    string
+		enabled bool
+	}{
+		{"server", true},
+		{"clients", ms.RedisClientsConnected.Enabled || ms.RedisClientsBlocked.Enabled ||
+			ms.RedisClientsMaxInputBuffer.Enabled || ms.RedisClientsMaxOutputBuffer.Enabled},
+		{"memory", ms.RedisMemoryUsed.Enabled || ms.RedisMemoryPeak.Enabled || ms.RedisMemoryRss.Enabled ||
+			ms.RedisMemoryLua.Enabled || ms.RedisMemoryFragmentationRatio.Enabled || ms.RedisMaxmemory.Enabled ||
+			ms.RedisMemoryTotalSystem.Enabled || ms.RedisMemoryUtilization.Enabled || ras.RedisMaxmemoryPolicy.Enabled},
+		{"persistence", ms.RedisPersistenceLoading.Enabled || ms.RedisRdbChangesSinceLastSave.Enabled ||
+			ms.RedisRdbLastSaveTime.Enabled || ms.RedisRdbBgsaveInProgress.Enabled || ms.RedisRdbLastBgsaveDuration.Enabled ||
+			ms.RedisRdbLastBgsaveStatus.Enabled || ms.RedisAofEnabled.Enabled || ms.RedisAofRewriteInProgress.Enabled ||
+			ms.RedisAofLastBgrewriteStatus.Enabled || ms.RedisAofLastWriteStatus.Enabled || ms.RedisAofCurrentSize.Enabled ||
+			ms.RedisAofBaseSize.Enabled},
+		{"stats", ms.RedisKeysExpired.Enabled || ms.RedisKeysEvicted.Enabled || ms.RedisConnectionsReceived.Enabled ||
+			ms.RedisConnectionsRejected.Enabled || ms.RedisCommands.Enabled || ms.RedisCommandsProcessed.Enabled ||
+			ms.RedisNetInput.Enabled || ms.RedisNetOutput.Enabled || ms.RedisKeyspaceHits.Enabled ||
+			ms.RedisKeyspaceMisses.Enabled || ms.RedisLatestFork.Enabled || ms.RedisPubsubChannels.Enabled ||
+			ms.RedisPubsubPatterns.Enabled || ms.RedisErrorReplies.Enabled},
+		{"replication", ms.RedisSlavesConnected.Enabled || ms.RedisReplicationBacklogFirstByteOffset.Enabled ||
+			ms.RedisReplicationOffset.Enabled || ms.RedisReplicationReplicaOffsetDelta.Enabled ||
+			ms.RedisReplicationReplicaLag.Enabled || ms.RedisReplicationReplicaState.Enabled ||
+			ms.RedisReplicationMasterLinkUp.Enabled || ms.RedisReplicationMasterLinkLastIo.Enabled ||
+			ms.RedisReplicationMasterLinkSyncInProgress.Enabled || ms.RedisReplicationMasterLinkDownSince.Enabled ||
+			ras.RedisRole.Enabled || cfg.Heartbeat.Enabled},
+		{"cpu", ms.RedisCPUTime.Enabled},
+		// The latency histogram takes its sum from commandstats.
+		{"commandstats", ms.RedisCommandCalls.Enabled || ms.RedisCommandUsec.Enabled || ms.RedisCommandUsecPerCall.Enabled ||
+			ms.RedisCommandRejectedCalls.Enabled || ms.RedisCommandFailedCalls.Enabled || cfg.LatencyHistogram.Enabled},
+		{"latencystats", ms.RedisLatencystatP50.Enabled || ms.RedisLatencystatP90.Enabled || ms.RedisLatencystatP99.Enabled ||
+			ms.RedisLatencystatP999.Enabled || ms.RedisLatencystatP9999.Enabled || ms.RedisLatencystatP100.Enabled},
+		{"errorstats", ms.RedisErrors.Enabled},
+		// Sampling big keys, walking key patterns and discovering streams go
+		// through the databases listed in keyspace.
+		{"keyspace", ms.RedisDbKeys.Enabled || ms.RedisDbExpires.Enabled || ms.RedisDbAvgTTL.Enabled ||
+			ms.RedisDbSubexpiry.Enabled || cfg.BigKeys.Enabled || len(cfg.KeyPatterns.Patterns) > 0 || cfg.Streams.Discovery},
+	}
+
+	var sections []string
+	for _, c := range candidates {
+		if c.enabled || customSections[c.name] {
+			sections = append(sections, c.name)
+			delete(customSections, c.name)
+		}
+	}
+	// Sections without any known metric, e.g. modules.
+	extra := make([]string, 0, len(customSections))
+	for section := range customSections {
+		extra = append(extra, section)
+	}
+	sort.Strings(extra)
+	return append(sections, extra...)
+}
+
+// withFeatureSections adds to the configured sections those the enabled
+// features and the custom metrics read, unless already selected, so that
+// configuring the sections only leaves out metrics.
+func withFeatureSections(cfg *Config, configured []string) []string {
+	selected := map[string]bool{}
+	for _, section := range configured {
+		selected[strings.ToLower(section)] = true
+	}
+	if selected["all"] || selected["everything"] {
+		return configured
+	}
+
+	var needed []string
+	if cfg.Heartbeat.Enabled {
+		needed = append(needed, "replication")
+	}
+	if cfg.LatencyHistogram.Enabled {
+		needed = append(needed, "commandstats")
+	}
+	if cfg.BigKeys.Enabled || len(cfg.KeyPatterns.Patterns) > 0 || cfg.Streams.Discovery {
+		needed = append(needed, "keyspace")
+	}
+	for _, cm := range cfg.CustomMetrics {
+		if cm.Section != "" {
+			needed = append(needed, strings.ToLower(cm.Section))
+		}
+	}
+
+	sections := append([]string{}, configured...)
+	for _, section := range needed {
+		if selected[section] || selected["default"] && !infoNonDefaultSections[section] {
+			continue
+		}
+		selected[section] = true
+		sections = append(sections, section)
+	}
+	return sections
+}
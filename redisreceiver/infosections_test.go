@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestInfoSections(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(cfg *Config)
+		expected []string
+	}{
+		{
+			name:   "default",
+			modify: func(cfg *Config) {},
+			expected: []string{"server", "clients", "memory", "persistence", "stats", "replication", "cpu",
+				"commandstats", "latencystats", "errorstats", "keyspace"},
+		},
+		{
+			name: "configured",
+			modify: func(cfg *Config) {
+				cfg.Info.Sections = []string{"everything"}
+			},
+			expected: []string{"everything"},
+		},
+		{
+			name: "configured without the sections of enabled features",
+			modify: func(cfg *Config) {
+				cfg.Info.Sections = []string{"server", "Memory", "keyspace"}
+				cfg.Heartbeat.Enabled = true
+				cfg.LatencyHistogram.Enabled = true
+				cfg.Streams.Discovery = true
+				cfg.CustomMetrics = []CustomMetricSettings{
+					{Name: "redis.modules.custom", Key: "module", Section: "Modules"},
+					{Name: "redis.memory.custom", Key: "mem_custom", Section: "memory"},
+				}
+			},
+			expected: []string{"server", "Memory", "keyspace", "replication", "commandstats", "modules"},
+		},
+		{
+			name: "configured default sections",
+			modify: func(cfg *Config) {
+				cfg.Info.Sections = []string{"default"}
+				cfg.Heartbeat.Enabled = true
+				cfg.LatencyHistogram.Enabled = true
+			},
+			expected: []string{"default", "commandstats"},
+		},
+		{
+			name: "disabled metrics",
+			modify: func(cfg *Config) {
+				cfg.Metrics.RedisCPUTime.Enabled = false
+				cfg.Metrics.RedisErrors.Enabled = false
+				cfg.Metrics.RedisDbKeys.Enabled = false
+				cfg.Metrics.RedisDbExpires.Enabled = false
+				cfg.Metrics.RedisDbAvgTTL.Enabled = false
+				cfg.Metrics.RedisDbSubexpiry.Enabled = false
+			},
+			expected: []string{"server", "clients", "memory", "persistence", "stats", "replication",
+				"commandstats", "latencystats"},
+		},
+		{
+			name: "disabled metrics of a section needed by a feature",
+			modify: func(cfg *Config) {
+				cfg.Metrics.RedisDbKeys.Enabled = false
+				cfg.Metrics.RedisDbExpires.Enabled = false
+				cfg.Metrics.RedisDbAvgTTL.Enabled = false
+				cfg.Metrics.RedisDbSubexpiry.Enabled = false
+				cfg.Streams.Discovery = true
+			},
+			expected: []string{"server", "clients", "memory", "persistence", "stats", "replication", "cpu",
+				"commandstats", "latencystats", "errorstats", "keyspace"},
+		},
+		{
+			name: "custom metrics",
+			modify: func(cfg *Config) {
+				cfg.Metrics.RedisCPUTime.Enabled = false
+				cfg.CustomMetrics = []CustomMetricSettings{
+					{Name: "redis.cpu.main_thread", Key: "used_cpu_sys_main_thread", Section: "cpu"},
+					{Name: "redis.modules.custom", Key: "module", Section: "Modules"},
+				}
+			},
+			expected: []string{"server", "clients", "memory", "persistence", "stats", "replication", "cpu",
+				"commandstats", "latencystats", "errorstats", "keyspace", "modules"},
+		},
+		{
+			name: "custom metrics without section",
+			modify: func(cfg *Config) {
+				cfg.CustomMetrics = []CustomMetricSettings{{Name: "redis.forked.field", Key: "forked_field"}}
+			},
+			expected: []string{"everything"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			test.modify(cfg)
+			assert.Equal(t, test.expected, infoSections(cfg))
+		})
+	}
+}
+
+// sectionsFakeClient records the INFO sections it is asked for.
+type sectionsFakeClient struct {
+	fakeClient
+	sections [][]string
+}
+
+func (c *sectionsFakeClient) retrieveInfo(sections []string) (string, error) {
+	c.sections = append(c.sections, sections)
+	return c.fakeClient.retrieveInfo(sections)
+}
+
+func TestRedisScraperInfoSections(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Info.Sections = []string{"server", "memory"}
+	client := &sectionsFakeClient{}
+	runner, err := newRedisScraperWithClient(client, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	_, err = runner.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"server", "memory"}}, client.sections)
+}
@@ -15,62 +15,141 @@ type MetricSettings struct {
 
 // MetricsSettings provides settings for redisreceiver metrics.
 type MetricsSettings struct {
-	RedisClientsBlocked                    MetricSettings `mapstructure:"redis.clients.blocked"`
-	RedisClientsConnected                  MetricSettings `mapstructure:"redis.clients.connected"`
-	RedisClientsMaxInputBuffer             MetricSettings `mapstructure:"redis.clients.max_input_buffer"`
-	RedisClientsMaxOutputBuffer            MetricSettings `mapstructure:"redis.clients.max_output_buffer"`
-	RedisCommandCalls                      MetricSettings `mapstructure:"redis.command.calls"`
-	RedisCommandFailedCalls                MetricSettings `mapstructure:"redis.command.failed_calls"`
-	RedisCommandRejectedCalls              MetricSettings `mapstructure:"redis.command.rejected_calls"`
-	RedisCommandUsec                       MetricSettings `mapstructure:"redis.command.usec"`
-	RedisCommandUsecPerCall                MetricSettings `mapstructure:"redis.command.usec_per_call"`
-	RedisCommands                          MetricSettings `mapstructure:"redis.commands"`
-	RedisCommandsProcessed                 MetricSettings `mapstructure:"redis.commands.processed"`
-	RedisConnectionsReceived               MetricSettings `mapstructure:"redis.connections.received"`
-	RedisConnectionsRejected               MetricSettings `mapstructure:"redis.connections.rejected"`
-	RedisCPUTime                           MetricSettings `mapstructure:"redis.cpu.time"`
-	RedisDbAvgTTL                          MetricSettings `mapstructure:"redis.db.avg_ttl"`
-	RedisDbExpires                         MetricSettings `mapstructure:"redis.db.expires"`
-	RedisDbKeys                            MetricSettings `mapstructure:"redis.db.keys"`
-	RedisKeysEvicted                       MetricSettings `mapstructure:"redis.keys.evicted"`
-	RedisKeysExpired                       MetricSettings `mapstructure:"redis.keys.expired"`
-	RedisKeyspaceHits                      MetricSettings `mapstructure:"redis.keyspace.hits"`
-	RedisKeyspaceMisses                    MetricSettings `mapstructure:"redis.keyspace.misses"`
-	RedisLatencystatP100                   MetricSettings `mapstructure:"redis.latencystat.p100"`
-	RedisLatencystatP50                    MetricSettings `mapstructure:"redis.latencystat.p50"`
-	RedisLatencystatP90                    MetricSettings `mapstructure:"redis.latencystat.p90"`
-	RedisLatencystatP99                    MetricSettings `mapstructure:"redis.latencystat.p99"`
-	RedisLatencystatP999                   MetricSettings `mapstructure:"redis.latencystat.p99.9"`
-	RedisLatencystatP9999                  MetricSettings `mapstructure:"redis.latencystat.p99.99"`
-	RedisLatestFork                        MetricSettings `mapstructure:"redis.latest_fork"`
-	RedisMemoryFragmentationRatio          MetricSettings `mapstructure:"redis.memory.fragmentation_ratio"`
-	RedisMemoryLua                         MetricSettings `mapstructure:"redis.memory.lua"`
-	RedisMemoryPeak                        MetricSettings `mapstructure:"redis.memory.peak"`
-	RedisMemoryRss                         MetricSettings `mapstructure:"redis.memory.rss"`
-	RedisMemoryUsed                        MetricSettings `mapstructure:"redis.memory.used"`
-	RedisNetInput                          MetricSettings `mapstructure:"redis.net.input"`
-	RedisNetOutput                         MetricSettings `mapstructure:"redis.net.output"`
-	RedisRdbChangesSinceLastSave           MetricSettings `mapstructure:"redis.rdb.changes_since_last_save"`
-	RedisReplicationBacklogFirstByteOffset MetricSettings `mapstructure:"redis.replication.backlog_first_byte_offset"`
-	RedisReplicationOffset                 MetricSettings `mapstructure:"redis.replication.offset"`
-	RedisSlavesConnected                   MetricSettings `mapstructure:"redis.slaves.connected"`
-	RedisUptime                            MetricSettings `mapstructure:"redis.uptime"`
+	RedisAofBaseSize                         MetricSettings `mapstructure:"redis.aof.base_size"`
+	RedisAofCurrentSize                      MetricSettings `mapstructure:"redis.aof.current_size"`
+	RedisAofEnabled                          MetricSettings `mapstructure:"redis.aof.enabled"`
+	RedisAofLastBgrewriteStatus              MetricSettings `mapstructure:"redis.aof.last_bgrewrite.status"`
+	RedisAofLastWriteStatus                  MetricSettings `mapstructure:"redis.aof.last_write.status"`
+	RedisAofRewriteInProgress                MetricSettings `mapstructure:"redis.aof.rewrite.in_progress"`
+	RedisClientsBlocked                      MetricSettings `mapstructure:"redis.clients.blocked"`
+	RedisClientsConnected                    MetricSettings `mapstructure:"redis.clients.connected"`
+	RedisClientsConnections                  MetricSettings `mapstructure:"redis.clients.connections"`
+	RedisClientsIdleTime                     MetricSettings `mapstructure:"redis.clients.idle_time"`
+	RedisClientsMaxInputBuffer               MetricSettings `mapstructure:"redis.clients.max_input_buffer"`
+	RedisClientsMaxOutputBuffer              MetricSettings `mapstructure:"redis.clients.max_output_buffer"`
+	RedisClientsOutputMemory                 MetricSettings `mapstructure:"redis.clients.output_memory"`
+	RedisClientsQueryBuffer                  MetricSettings `mapstructure:"redis.clients.query_buffer"`
+	RedisCommandCalls                        MetricSettings `mapstructure:"redis.command.calls"`
+	RedisCommandFailedCalls                  MetricSettings `mapstructure:"redis.command.failed_calls"`
+	RedisCommandRejectedCalls                MetricSettings `mapstructure:"redis.command.rejected_calls"`
+	RedisCommandUsec                         MetricSettings `mapstructure:"redis.command.usec"`
+	RedisCommandUsecPerCall                  MetricSettings `mapstructure:"redis.command.usec_per_call"`
+	RedisCommands                            MetricSettings `mapstructure:"redis.commands"`
+	RedisCommandsProcessed                   MetricSettings `mapstructure:"redis.commands.processed"`
+	RedisConfigInfo                          MetricSettings `mapstructure:"redis.config.info"`
+	RedisConfigValue                         MetricSettings `mapstructure:"redis.config.value"`
+	RedisConnectionsReceived                 MetricSettings `mapstructure:"redis.connections.received"`
+	RedisConnectionsRejected                 MetricSettings `mapstructure:"redis.connections.rejected"`
+	RedisCPUTime                             MetricSettings `mapstructure:"redis.cpu.time"`
+	RedisDbAvgTTL                            MetricSettings `mapstructure:"redis.db.avg_ttl"`
+	RedisDbExpires                           MetricSettings `mapstructure:"redis.db.expires"`
+	RedisDbKeys                              MetricSettings `mapstructure:"redis.db.keys"`
+	RedisDbSubexpiry                         MetricSettings `mapstructure:"redis.db.subexpiry"`
+	RedisErrorReplies                        MetricSettings `mapstructure:"redis.error_replies"`
+	RedisErrors                              MetricSettings `mapstructure:"redis.errors"`
+	RedisKeyLength                           MetricSettings `mapstructure:"redis.key.length"`
+	RedisKeyMemoryUsage                      MetricSettings `mapstructure:"redis.key.memory_usage"`
+	RedisKeysEvicted                         MetricSettings `mapstructure:"redis.keys.evicted"`
+	RedisKeysExpired                         MetricSettings `mapstructure:"redis.keys.expired"`
+	RedisKeysPatternCount                    MetricSettings `mapstructure:"redis.keys.pattern.count"`
+	RedisKeysPatternMemoryUsage              MetricSettings `mapstructure:"redis.keys.pattern.memory_usage"`
+	RedisKeyspaceHits                        MetricSettings `mapstructure:"redis.keyspace.hits"`
+	RedisKeyspaceMisses                      MetricSettings `mapstructure:"redis.keyspace.misses"`
+	RedisLatencystatP100                     MetricSettings `mapstructure:"redis.latencystat.p100"`
+	RedisLatencystatP50                      MetricSettings `mapstructure:"redis.latencystat.p50"`
+	RedisLatencystatP90                      MetricSettings `mapstructure:"redis.latencystat.p90"`
+	RedisLatencystatP99                      MetricSettings `mapstructure:"redis.latencystat.p99"`
+	RedisLatencystatP999                     MetricSettings `mapstructure:"redis.latencystat.p99.9"`
+	RedisLatencystatP9999                    MetricSettings `mapstructure:"redis.latencystat.p99.99"`
+	RedisLatestFork                          MetricSettings `mapstructure:"redis.latest_fork"`
+	RedisMaxmemory                           MetricSettings `mapstructure:"redis.maxmemory"`
+	RedisMemoryFragmentationRatio            MetricSettings `mapstructure:"redis.memory.fragmentation_ratio"`
+	RedisMemoryLua                           MetricSettings `mapstructure:"redis.memory.lua"`
+	RedisMemoryPeak                          MetricSettings `mapstructure:"redis.memory.peak"`
+	RedisMemoryRss                           MetricSettings `mapstructure:"redis.memory.rss"`
+	RedisMemoryTotalSystem                   MetricSettings `mapstructure:"redis.memory.total_system"`
+	RedisMemoryUsed                          MetricSettings `mapstructure:"redis.memory.used"`
+	RedisMemoryUtilization                   MetricSettings `mapstructure:"redis.memory.utilization"`
+	RedisNetInput                            MetricSettings `mapstructure:"redis.net.input"`
+	RedisNetOutput                           MetricSettings `mapstructure:"redis.net.output"`
+	RedisPersistenceLoading                  MetricSettings `mapstructure:"redis.persistence.loading"`
+	RedisProbeLatency                        MetricSettings `mapstructure:"redis.probe.latency"`
+	RedisProbeSuccess                        MetricSettings `mapstructure:"redis.probe.success"`
+	RedisPubsubChannelSubscribers            MetricSettings `mapstructure:"redis.pubsub.channel.subscribers"`
+	RedisPubsubChannels                      MetricSettings `mapstructure:"redis.pubsub.channels"`
+	RedisPubsubPatterns                      MetricSettings `mapstructure:"redis.pubsub.patterns"`
+	RedisPubsubShardChannelSubscribers       MetricSettings `mapstructure:"redis.pubsub.shard_channel.subscribers"`
+	RedisRdbBgsaveInProgress                 MetricSettings `mapstructure:"redis.rdb.bgsave.in_progress"`
+	RedisRdbChangesSinceLastSave             MetricSettings `mapstructure:"redis.rdb.changes_since_last_save"`
+	RedisRdbLastBgsaveDuration               MetricSettings `mapstructure:"redis.rdb.last_bgsave.duration"`
+	RedisRdbLastBgsaveStatus                 MetricSettings `mapstructure:"redis.rdb.last_bgsave.status"`
+	RedisRdbLastSaveTime                     MetricSettings `mapstructure:"redis.rdb.last_save.time"`
+	RedisReplicationBacklogFirstByteOffset   MetricSettings `mapstructure:"redis.replication.backlog_first_byte_offset"`
+	RedisReplicationHeartbeatLag             MetricSettings `mapstructure:"redis.replication.heartbeat_lag"`
+	RedisReplicationMasterLinkDownSince      MetricSettings `mapstructure:"redis.replication.master_link.down_since"`
+	RedisReplicationMasterLinkLastIo         MetricSettings `mapstructure:"redis.replication.master_link.last_io"`
+	RedisReplicationMasterLinkSyncInProgress MetricSettings `mapstructure:"redis.replication.master_link.sync_in_progress"`
+	RedisReplicationMasterLinkUp             MetricSettings `mapstructure:"redis.replication.master_link.up"`
+	RedisReplicationOffset                   MetricSettings `mapstructure:"redis.replication.offset"`
+	RedisReplicationReplicaLag               MetricSettings `mapstructure:"redis.replication.replica.lag"`
+	RedisReplicationReplicaOffsetDelta       MetricSettings `mapstructure:"redis.replication.replica.offset_delta"`
+	RedisReplicationReplicaState             MetricSettings `mapstructure:"redis.replication.replica.state"`
+	RedisScrapeError                         MetricSettings `mapstructure:"redis.scrape.error"`
+	RedisSlavesConnected                     MetricSettings `mapstructure:"redis.slaves.connected"`
+	RedisStreamConsumerIdle                  MetricSettings `mapstructure:"redis.stream.consumer.idle"`
+	RedisStreamConsumerPending               MetricSettings `mapstructure:"redis.stream.consumer.pending"`
+	RedisStreamGroupLag                      MetricSettings `mapstructure:"redis.stream.group.lag"`
+	RedisStreamGroupLastDeliveredAge         MetricSettings `mapstructure:"redis.stream.group.last_delivered_age"`
+	RedisStreamGroupPending                  MetricSettings `mapstructure:"redis.stream.group.pending"`
+	RedisUp                                  MetricSettings `mapstructure:"redis.up"`
+	RedisUptime                              MetricSettings `mapstructure:"redis.uptime"`
+	RedisWatchedKeyLength                    MetricSettings `mapstructure:"redis.watched_key.length"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
 	return MetricsSettings{
+		RedisAofBaseSize: MetricSettings{
+			Enabled: true,
+		},
+		RedisAofCurrentSize: MetricSettings{
+			Enabled: true,
+		},
+		RedisAofEnabled: MetricSettings{
+			Enabled: true,
+		},
+		RedisAofLastBgrewriteStatus: MetricSettings{
+			Enabled: true,
+		},
+		RedisAofLastWriteStatus: MetricSettings{
+			Enabled: true,
+		},
+		RedisAofRewriteInProgress: MetricSettings{
+			Enabled: true,
+		},
 		RedisClientsBlocked: MetricSettings{
 			Enabled: true,
 		},
 		RedisClientsConnected: MetricSettings{
 			Enabled: true,
 		},
+		RedisClientsConnections: MetricSettings{
+			Enabled: true,
+		},
+		RedisClientsIdleTime: MetricSettings{
+			Enabled: true,
+		},
 		RedisClientsMaxInputBuffer: MetricSettings{
 			Enabled: true,
 		},
 		RedisClientsMaxOutputBuffer: MetricSettings{
 			Enabled: true,
 		},
+		RedisClientsOutputMemory: MetricSettings{
+			Enabled: true,
+		},
+		RedisClientsQueryBuffer: MetricSettings{
+			Enabled: true,
+		},
 		RedisCommandCalls: MetricSettings{
 			Enabled: true,
 		},
@@ -92,6 +171,12 @@ func DefaultMetricsSettings() MetricsSettings {
 		RedisCommandsProcessed: MetricSettings{
 			Enabled: true,
 		},
+		RedisConfigInfo: MetricSettings{
+			Enabled: true,
+		},
+		RedisConfigValue: MetricSettings{
+			Enabled: true,
+		},
 		RedisConnectionsReceived: MetricSettings{
 			Enabled: true,
 		},
@@ -110,12 +195,33 @@ func DefaultMetricsSettings() MetricsSettings {
 		RedisDbKeys: MetricSettings{
 			Enabled: true,
 		},
+		RedisDbSubexpiry: MetricSettings{
+			Enabled: true,
+		},
+		RedisErrorReplies: MetricSettings{
+			Enabled: true,
+		},
+		RedisErrors: MetricSettings{
+			Enabled: true,
+		},
+		RedisKeyLength: MetricSettings{
+			Enabled: true,
+		},
+		RedisKeyMemoryUsage: MetricSettings{
+			Enabled: true,
+		},
 		RedisKeysEvicted: MetricSettings{
 			Enabled: true,
 		},
 		RedisKeysExpired: MetricSettings{
 			Enabled: true,
 		},
+		RedisKeysPatternCount: MetricSettings{
+			Enabled: true,
+		},
+		RedisKeysPatternMemoryUsage: MetricSettings{
+			Enabled: true,
+		},
 		RedisKeyspaceHits: MetricSettings{
 			Enabled: true,
 		},
@@ -143,6 +249,9 @@ func DefaultMetricsSettings() MetricsSettings {
 		RedisLatestFork: MetricSettings{
 			Enabled: true,
 		},
+		RedisMaxmemory: MetricSettings{
+			Enabled: true,
+		},
 		RedisMemoryFragmentationRatio: MetricSettings{
 			Enabled: true,
 		},
@@ -155,77 +264,162 @@ func DefaultMetricsSettings() MetricsSettings {
 		RedisMemoryRss: MetricSettings{
 			Enabled: true,
 		},
+		RedisMemoryTotalSystem: MetricSettings{
+			Enabled: true,
+		},
 		RedisMemoryUsed: MetricSettings{
 			Enabled: true,
 		},
+		RedisMemoryUtilization: MetricSettings{
+			Enabled: true,
+		},
 		RedisNetInput: MetricSettings{
 			Enabled: true,
 		},
 		RedisNetOutput: MetricSettings{
 			Enabled: true,
 		},
+		RedisPersistenceLoading: MetricSettings{
+			Enabled: true,
+		},
+		RedisProbeLatency: MetricSettings{
+			Enabled: true,
+		},
+		RedisProbeSuccess: MetricSettings{
+			Enabled: true,
+		},
+		RedisPubsubChannelSubscribers: MetricSettings{
+			Enabled: true,
+		},
+		RedisPubsubChannels: MetricSettings{
+			Enabled: true,
+		},
+		RedisPubsubPatterns: MetricSettings{
+			Enabled: true,
+		},
+		RedisPubsubShardChannelSubscribers: MetricSettings{
+			Enabled: true,
+		},
+		RedisRdbBgsaveInProgress: MetricSettings{
+			Enabled: true,
+		},
 		RedisRdbChangesSinceLastSave: MetricSettings{
 			Enabled: true,
 		},
+		RedisRdbLastBgsaveDuration: MetricSettings{
+			Enabled: true,
+		},
+		RedisRdbLastBgsaveStatus: MetricSettings{
+			Enabled: true,
+		},
+		RedisRdbLastSaveTime: MetricSettings{
+			Enabled: true,
+		},
 		RedisReplicationBacklogFirstByteOffset: MetricSettings{
 			Enabled: true,
 		},
+		RedisReplicationHeartbeatLag: MetricSettings{
+			Enabled: true,
+		},
+		RedisReplicationMasterLinkDownSince: MetricSettings{
+			Enabled: true,
+		},
+		RedisReplicationMasterLinkLastIo: MetricSettings{
+			Enabled: true,
+		},
+		RedisReplicationMasterLinkSyncInProgress: MetricSettings{
+			Enabled: true,
+		},
+		RedisReplicationMasterLinkUp: MetricSettings{
+			Enabled: true,
+		},
 		RedisReplicationOffset: MetricSettings{
 			Enabled: true,
 		},
+		RedisReplicationReplicaLag: MetricSettings{
+			Enabled: true,
+		},
+		RedisReplicationReplicaOffsetDelta: MetricSettings{
+			Enabled: true,
+		},
+		RedisReplicationReplicaState: MetricSettings{
+			Enabled: true,
+		},
+		RedisScrapeError: MetricSettings{
+			Enabled: true,
+		},
 		RedisSlavesConnected: MetricSettings{
 			Enabled: true,
 		},
+		RedisStreamConsumerIdle: MetricSettings{
+			Enabled: true,
+		},
+		RedisStreamConsumerPending: MetricSettings{
+			Enabled: true,
+		},
+		RedisStreamGroupLag: MetricSettings{
+			Enabled: true,
+		},
+		RedisStreamGroupLastDeliveredAge: MetricSettings{
+			Enabled: true,
+		},
+		RedisStreamGroupPending: MetricSettings{
+			Enabled: true,
+		},
+		RedisUp: MetricSettings{
+			Enabled: true,
+		},
 		RedisUptime: MetricSettings{
 			Enabled: true,
 		},
+		RedisWatchedKeyLength: MetricSettings{
+			Enabled: true,
+		},
 	}
 }
 
-type metricRedisClientsBlocked struct {
+type metricRedisAofBaseSize struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.clients.blocked metric with initial data.
-func (m *metricRedisClientsBlocked) init() {
-	m.data.SetName("redis.clients.blocked")
-	m.data.SetDescription("Number of clients pending on a blocking call")
-	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(false)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+// init fills redis.aof.base_size metric with initial data.
+func (m *metricRedisAofBaseSize) init() {
+	m.data.SetName("redis.aof.base_size")
+	m.data.SetDescription("Size of the AOF at the last startup or rewrite, only reported when AOF is enabled")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
 }
 
-func (m *metricRedisClientsBlocked) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisAofBaseSize) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisClientsBlocked) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisAofBaseSize) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisClientsBlocked) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisAofBaseSize) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisClientsBlocked(settings MetricSettings) metricRedisClientsBlocked {
-	m := metricRedisClientsBlocked{settings: settings}
+func newMetricRedisAofBaseSize(settings MetricSettings) metricRedisAofBaseSize {
+	m := metricRedisAofBaseSize{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -233,50 +427,48 @@ func newMetricRedisClientsBlocked(settings MetricSettings) metricRedisClientsBlo
 	return m
 }
 
-type metricRedisClientsConnected struct {
+type metricRedisAofCurrentSize struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.clients.connected metric with initial data.
-func (m *metricRedisClientsConnected) init() {
-	m.data.SetName("redis.clients.connected")
-	m.data.SetDescription("Number of client connections (excluding connections from replicas)")
-	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(false)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+// init fills redis.aof.current_size metric with initial data.
+func (m *metricRedisAofCurrentSize) init() {
+	m.data.SetName("redis.aof.current_size")
+	m.data.SetDescription("Current size of the AOF, only reported when AOF is enabled")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
 }
 
-func (m *metricRedisClientsConnected) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisAofCurrentSize) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisClientsConnected) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisAofCurrentSize) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisClientsConnected) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisAofCurrentSize) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisClientsConnected(settings MetricSettings) metricRedisClientsConnected {
-	m := metricRedisClientsConnected{settings: settings}
+func newMetricRedisAofCurrentSize(settings MetricSettings) metricRedisAofCurrentSize {
+	m := metricRedisAofCurrentSize{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -284,21 +476,21 @@ func newMetricRedisClientsConnected(settings MetricSettings) metricRedisClientsC
 	return m
 }
 
-type metricRedisClientsMaxInputBuffer struct {
+type metricRedisAofEnabled struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.clients.max_input_buffer metric with initial data.
-func (m *metricRedisClientsMaxInputBuffer) init() {
-	m.data.SetName("redis.clients.max_input_buffer")
-	m.data.SetDescription("Biggest input buffer among current client connections")
+// init fills redis.aof.enabled metric with initial data.
+func (m *metricRedisAofEnabled) init() {
+	m.data.SetName("redis.aof.enabled")
+	m.data.SetDescription("Whether AOF logging is enabled, 1 if enabled and 0 otherwise")
 	m.data.SetUnit("")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
 }
 
-func (m *metricRedisClientsMaxInputBuffer) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisAofEnabled) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -309,14 +501,14 @@ func (m *metricRedisClientsMaxInputBuffer) recordDataPoint(start pdata.Timestamp
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisClientsMaxInputBuffer) updateCapacity() {
+func (m *metricRedisAofEnabled) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisClientsMaxInputBuffer) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisAofEnabled) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -324,8 +516,8 @@ func (m *metricRedisClientsMaxInputBuffer) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisClientsMaxInputBuffer(settings MetricSettings) metricRedisClientsMaxInputBuffer {
-	m := metricRedisClientsMaxInputBuffer{settings: settings}
+func newMetricRedisAofEnabled(settings MetricSettings) metricRedisAofEnabled {
+	m := metricRedisAofEnabled{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -333,21 +525,21 @@ func newMetricRedisClientsMaxInputBuffer(settings MetricSettings) metricRedisCli
 	return m
 }
 
-type metricRedisClientsMaxOutputBuffer struct {
+type metricRedisAofLastBgrewriteStatus struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.clients.max_output_buffer metric with initial data.
-func (m *metricRedisClientsMaxOutputBuffer) init() {
-	m.data.SetName("redis.clients.max_output_buffer")
-	m.data.SetDescription("Longest output list among current client connections")
+// init fills redis.aof.last_bgrewrite.status metric with initial data.
+func (m *metricRedisAofLastBgrewriteStatus) init() {
+	m.data.SetName("redis.aof.last_bgrewrite.status")
+	m.data.SetDescription("Whether the last AOF rewrite succeeded, 1 if ok and 0 if err")
 	m.data.SetUnit("")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
 }
 
-func (m *metricRedisClientsMaxOutputBuffer) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisAofLastBgrewriteStatus) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -358,14 +550,14 @@ func (m *metricRedisClientsMaxOutputBuffer) recordDataPoint(start pdata.Timestam
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisClientsMaxOutputBuffer) updateCapacity() {
+func (m *metricRedisAofLastBgrewriteStatus) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisClientsMaxOutputBuffer) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisAofLastBgrewriteStatus) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -373,8 +565,8 @@ func (m *metricRedisClientsMaxOutputBuffer) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisClientsMaxOutputBuffer(settings MetricSettings) metricRedisClientsMaxOutputBuffer {
-	m := metricRedisClientsMaxOutputBuffer{settings: settings}
+func newMetricRedisAofLastBgrewriteStatus(settings MetricSettings) metricRedisAofLastBgrewriteStatus {
+	m := metricRedisAofLastBgrewriteStatus{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -382,52 +574,48 @@ func newMetricRedisClientsMaxOutputBuffer(settings MetricSettings) metricRedisCl
 	return m
 }
 
-type metricRedisCommandCalls struct {
+type metricRedisAofLastWriteStatus struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.command.calls metric with initial data.
-func (m *metricRedisCommandCalls) init() {
-	m.data.SetName("redis.command.calls")
-	m.data.SetDescription("Number of calls reached command execution")
+// init fills redis.aof.last_write.status metric with initial data.
+func (m *metricRedisAofLastWriteStatus) init() {
+	m.data.SetName("redis.aof.last_write.status")
+	m.data.SetDescription("Whether the last write to the AOF succeeded, 1 if ok and 0 if err")
 	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
-	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
 }
 
-func (m *metricRedisCommandCalls) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, commandAttributeValue string) {
+func (m *metricRedisAofLastWriteStatus) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
-	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisCommandCalls) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisAofLastWriteStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisCommandCalls) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisAofLastWriteStatus) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisCommandCalls(settings MetricSettings) metricRedisCommandCalls {
-	m := metricRedisCommandCalls{settings: settings}
+func newMetricRedisAofLastWriteStatus(settings MetricSettings) metricRedisAofLastWriteStatus {
+	m := metricRedisAofLastWriteStatus{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -435,52 +623,48 @@ func newMetricRedisCommandCalls(settings MetricSettings) metricRedisCommandCalls
 	return m
 }
 
-type metricRedisCommandFailedCalls struct {
+type metricRedisAofRewriteInProgress struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.command.failed_calls metric with initial data.
-func (m *metricRedisCommandFailedCalls) init() {
-	m.data.SetName("redis.command.failed_calls")
-	m.data.SetDescription("Number of failed calls of command")
+// init fills redis.aof.rewrite.in_progress metric with initial data.
+func (m *metricRedisAofRewriteInProgress) init() {
+	m.data.SetName("redis.aof.rewrite.in_progress")
+	m.data.SetDescription("Whether an AOF rewrite is in progress, 1 if rewriting and 0 otherwise")
 	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
-	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
 }
 
-func (m *metricRedisCommandFailedCalls) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, commandAttributeValue string) {
+func (m *metricRedisAofRewriteInProgress) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
-	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisCommandFailedCalls) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisAofRewriteInProgress) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisCommandFailedCalls) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisAofRewriteInProgress) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisCommandFailedCalls(settings MetricSettings) metricRedisCommandFailedCalls {
-	m := metricRedisCommandFailedCalls{settings: settings}
+func newMetricRedisAofRewriteInProgress(settings MetricSettings) metricRedisAofRewriteInProgress {
+	m := metricRedisAofRewriteInProgress{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -488,24 +672,23 @@ func newMetricRedisCommandFailedCalls(settings MetricSettings) metricRedisComman
 	return m
 }
 
-type metricRedisCommandRejectedCalls struct {
+type metricRedisClientsBlocked struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.command.rejected_calls metric with initial data.
-func (m *metricRedisCommandRejectedCalls) init() {
-	m.data.SetName("redis.command.rejected_calls")
-	m.data.SetDescription("Number of rejected calls of command")
+// init fills redis.clients.blocked metric with initial data.
+func (m *metricRedisClientsBlocked) init() {
+	m.data.SetName("redis.clients.blocked")
+	m.data.SetDescription("Number of clients pending on a blocking call")
 	m.data.SetUnit("")
 	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetIsMonotonic(false)
 	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
-	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisCommandRejectedCalls) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, commandAttributeValue string) {
+func (m *metricRedisClientsBlocked) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -513,18 +696,17 @@ func (m *metricRedisCommandRejectedCalls) recordDataPoint(start pdata.Timestamp,
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
-	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisCommandRejectedCalls) updateCapacity() {
+func (m *metricRedisClientsBlocked) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisCommandRejectedCalls) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisClientsBlocked) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -532,8 +714,8 @@ func (m *metricRedisCommandRejectedCalls) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisCommandRejectedCalls(settings MetricSettings) metricRedisCommandRejectedCalls {
-	m := metricRedisCommandRejectedCalls{settings: settings}
+func newMetricRedisClientsBlocked(settings MetricSettings) metricRedisClientsBlocked {
+	m := metricRedisClientsBlocked{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -541,24 +723,2533 @@ func newMetricRedisCommandRejectedCalls(settings MetricSettings) metricRedisComm
 	return m
 }
 
-type metricRedisCommandUsec struct {
+type metricRedisClientsConnected struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.command.usec metric with initial data.
-func (m *metricRedisCommandUsec) init() {
-	m.data.SetName("redis.command.usec")
-	m.data.SetDescription("Total CPU time consumed by command")
-	m.data.SetUnit("s")
+// init fills redis.clients.connected metric with initial data.
+func (m *metricRedisClientsConnected) init() {
+	m.data.SetName("redis.clients.connected")
+	m.data.SetDescription("Number of client connections (excluding connections from replicas)")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricRedisClientsConnected) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClientsConnected) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClientsConnected) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClientsConnected(settings MetricSettings) metricRedisClientsConnected {
+	m := metricRedisClientsConnected{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClientsConnections struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.clients.connections metric with initial data.
+func (m *metricRedisClientsConnections) init() {
+	m.data.SetName("redis.clients.connections")
+	m.data.SetDescription("Number of client connections per group, from CLIENT LIST")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisClientsConnections) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, clientNameAttributeValue string, clientLibNameAttributeValue string, clientUserAttributeValue string, clientDbAttributeValue string, clientFlagsAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.ClientName, pdata.NewAttributeValueString(clientNameAttributeValue))
+	dp.Attributes().Insert(A.ClientLibName, pdata.NewAttributeValueString(clientLibNameAttributeValue))
+	dp.Attributes().Insert(A.ClientUser, pdata.NewAttributeValueString(clientUserAttributeValue))
+	dp.Attributes().Insert(A.ClientDb, pdata.NewAttributeValueString(clientDbAttributeValue))
+	dp.Attributes().Insert(A.ClientFlags, pdata.NewAttributeValueString(clientFlagsAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClientsConnections) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClientsConnections) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClientsConnections(settings MetricSettings) metricRedisClientsConnections {
+	m := metricRedisClientsConnections{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClientsIdleTime struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.clients.idle_time metric with initial data.
+func (m *metricRedisClientsIdleTime) init() {
+	m.data.SetName("redis.clients.idle_time")
+	m.data.SetDescription("Total idle time of the client connections per group, from CLIENT LIST")
+	m.data.SetUnit("s")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisClientsIdleTime) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, clientNameAttributeValue string, clientLibNameAttributeValue string, clientUserAttributeValue string, clientDbAttributeValue string, clientFlagsAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.ClientName, pdata.NewAttributeValueString(clientNameAttributeValue))
+	dp.Attributes().Insert(A.ClientLibName, pdata.NewAttributeValueString(clientLibNameAttributeValue))
+	dp.Attributes().Insert(A.ClientUser, pdata.NewAttributeValueString(clientUserAttributeValue))
+	dp.Attributes().Insert(A.ClientDb, pdata.NewAttributeValueString(clientDbAttributeValue))
+	dp.Attributes().Insert(A.ClientFlags, pdata.NewAttributeValueString(clientFlagsAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClientsIdleTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClientsIdleTime) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClientsIdleTime(settings MetricSettings) metricRedisClientsIdleTime {
+	m := metricRedisClientsIdleTime{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClientsMaxInputBuffer struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.clients.max_input_buffer metric with initial data.
+func (m *metricRedisClientsMaxInputBuffer) init() {
+	m.data.SetName("redis.clients.max_input_buffer")
+	m.data.SetDescription("Biggest input buffer among current client connections")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisClientsMaxInputBuffer) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClientsMaxInputBuffer) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClientsMaxInputBuffer) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClientsMaxInputBuffer(settings MetricSettings) metricRedisClientsMaxInputBuffer {
+	m := metricRedisClientsMaxInputBuffer{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClientsMaxOutputBuffer struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.clients.max_output_buffer metric with initial data.
+func (m *metricRedisClientsMaxOutputBuffer) init() {
+	m.data.SetName("redis.clients.max_output_buffer")
+	m.data.SetDescription("Longest output list among current client connections")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisClientsMaxOutputBuffer) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClientsMaxOutputBuffer) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClientsMaxOutputBuffer) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClientsMaxOutputBuffer(settings MetricSettings) metricRedisClientsMaxOutputBuffer {
+	m := metricRedisClientsMaxOutputBuffer{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClientsOutputMemory struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.clients.output_memory metric with initial data.
+func (m *metricRedisClientsOutputMemory) init() {
+	m.data.SetName("redis.clients.output_memory")
+	m.data.SetDescription("Total memory used by the output buffers of the client connections per group, from CLIENT LIST")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisClientsOutputMemory) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, clientNameAttributeValue string, clientLibNameAttributeValue string, clientUserAttributeValue string, clientDbAttributeValue string, clientFlagsAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.ClientName, pdata.NewAttributeValueString(clientNameAttributeValue))
+	dp.Attributes().Insert(A.ClientLibName, pdata.NewAttributeValueString(clientLibNameAttributeValue))
+	dp.Attributes().Insert(A.ClientUser, pdata.NewAttributeValueString(clientUserAttributeValue))
+	dp.Attributes().Insert(A.ClientDb, pdata.NewAttributeValueString(clientDbAttributeValue))
+	dp.Attributes().Insert(A.ClientFlags, pdata.NewAttributeValueString(clientFlagsAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClientsOutputMemory) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClientsOutputMemory) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClientsOutputMemory(settings MetricSettings) metricRedisClientsOutputMemory {
+	m := metricRedisClientsOutputMemory{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisClientsQueryBuffer struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.clients.query_buffer metric with initial data.
+func (m *metricRedisClientsQueryBuffer) init() {
+	m.data.SetName("redis.clients.query_buffer")
+	m.data.SetDescription("Total query buffer size of the client connections per group, from CLIENT LIST")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisClientsQueryBuffer) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, clientNameAttributeValue string, clientLibNameAttributeValue string, clientUserAttributeValue string, clientDbAttributeValue string, clientFlagsAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.ClientName, pdata.NewAttributeValueString(clientNameAttributeValue))
+	dp.Attributes().Insert(A.ClientLibName, pdata.NewAttributeValueString(clientLibNameAttributeValue))
+	dp.Attributes().Insert(A.ClientUser, pdata.NewAttributeValueString(clientUserAttributeValue))
+	dp.Attributes().Insert(A.ClientDb, pdata.NewAttributeValueString(clientDbAttributeValue))
+	dp.Attributes().Insert(A.ClientFlags, pdata.NewAttributeValueString(clientFlagsAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisClientsQueryBuffer) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisClientsQueryBuffer) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisClientsQueryBuffer(settings MetricSettings) metricRedisClientsQueryBuffer {
+	m := metricRedisClientsQueryBuffer{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisCommandCalls struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.command.calls metric with initial data.
+func (m *metricRedisCommandCalls) init() {
+	m.data.SetName("redis.command.calls")
+	m.data.SetDescription("Number of calls reached command execution")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisCommandCalls) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, commandAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCommandCalls) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCommandCalls) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCommandCalls(settings MetricSettings) metricRedisCommandCalls {
+	m := metricRedisCommandCalls{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisCommandFailedCalls struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.command.failed_calls metric with initial data.
+func (m *metricRedisCommandFailedCalls) init() {
+	m.data.SetName("redis.command.failed_calls")
+	m.data.SetDescription("Number of failed calls of command")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisCommandFailedCalls) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, commandAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCommandFailedCalls) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCommandFailedCalls) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCommandFailedCalls(settings MetricSettings) metricRedisCommandFailedCalls {
+	m := metricRedisCommandFailedCalls{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisCommandRejectedCalls struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.command.rejected_calls metric with initial data.
+func (m *metricRedisCommandRejectedCalls) init() {
+	m.data.SetName("redis.command.rejected_calls")
+	m.data.SetDescription("Number of rejected calls of command")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisCommandRejectedCalls) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, commandAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCommandRejectedCalls) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCommandRejectedCalls) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCommandRejectedCalls(settings MetricSettings) metricRedisCommandRejectedCalls {
+	m := metricRedisCommandRejectedCalls{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisCommandUsec struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.command.usec metric with initial data.
+func (m *metricRedisCommandUsec) init() {
+	m.data.SetName("redis.command.usec")
+	m.data.SetDescription("Total CPU time consumed by command")
+	m.data.SetUnit("s")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisCommandUsec) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, commandAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCommandUsec) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCommandUsec) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCommandUsec(settings MetricSettings) metricRedisCommandUsec {
+	m := metricRedisCommandUsec{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisCommandUsecPerCall struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.command.usec_per_call metric with initial data.
+func (m *metricRedisCommandUsecPerCall) init() {
+	m.data.SetName("redis.command.usec_per_call")
+	m.data.SetDescription("Average CPU consumed per command execution")
+	m.data.SetUnit("ms")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisCommandUsecPerCall) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCommandUsecPerCall) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCommandUsecPerCall) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCommandUsecPerCall(settings MetricSettings) metricRedisCommandUsecPerCall {
+	m := metricRedisCommandUsecPerCall{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisCommands struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.commands metric with initial data.
+func (m *metricRedisCommands) init() {
+	m.data.SetName("redis.commands")
+	m.data.SetDescription("Number of commands processed per second")
+	m.data.SetUnit("{ops}/s")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisCommands) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCommands) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCommands) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCommands(settings MetricSettings) metricRedisCommands {
+	m := metricRedisCommands{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisCommandsProcessed struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.commands.processed metric with initial data.
+func (m *metricRedisCommandsProcessed) init() {
+	m.data.SetName("redis.commands.processed")
+	m.data.SetDescription("Total number of commands processed by the server")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricRedisCommandsProcessed) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCommandsProcessed) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCommandsProcessed) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCommandsProcessed(settings MetricSettings) metricRedisCommandsProcessed {
+	m := metricRedisCommandsProcessed{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisConfigInfo struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.config.info metric with initial data.
+func (m *metricRedisConfigInfo) init() {
+	m.data.SetName("redis.config.info")
+	m.data.SetDescription("Non-numeric server configuration parameter from CONFIG GET, with its value as an attribute, always 1")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisConfigInfo) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, parameterAttributeValue string, parameterValueAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Parameter, pdata.NewAttributeValueString(parameterAttributeValue))
+	dp.Attributes().Insert(A.ParameterValue, pdata.NewAttributeValueString(parameterValueAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisConfigInfo) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisConfigInfo) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisConfigInfo(settings MetricSettings) metricRedisConfigInfo {
+	m := metricRedisConfigInfo{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisConfigValue struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.config.value metric with initial data.
+func (m *metricRedisConfigValue) init() {
+	m.data.SetName("redis.config.value")
+	m.data.SetDescription("Value of a numeric server configuration parameter, from CONFIG GET")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisConfigValue) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, parameterAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.Parameter, pdata.NewAttributeValueString(parameterAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisConfigValue) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisConfigValue) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisConfigValue(settings MetricSettings) metricRedisConfigValue {
+	m := metricRedisConfigValue{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisConnectionsReceived struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.connections.received metric with initial data.
+func (m *metricRedisConnectionsReceived) init() {
+	m.data.SetName("redis.connections.received")
+	m.data.SetDescription("Total number of connections accepted by the server")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricRedisConnectionsReceived) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisConnectionsReceived) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisConnectionsReceived) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisConnectionsReceived(settings MetricSettings) metricRedisConnectionsReceived {
+	m := metricRedisConnectionsReceived{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisConnectionsRejected struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.connections.rejected metric with initial data.
+func (m *metricRedisConnectionsRejected) init() {
+	m.data.SetName("redis.connections.rejected")
+	m.data.SetDescription("Number of connections rejected because of maxclients limit")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricRedisConnectionsRejected) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisConnectionsRejected) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisConnectionsRejected) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisConnectionsRejected(settings MetricSettings) metricRedisConnectionsRejected {
+	m := metricRedisConnectionsRejected{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisCPUTime struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.cpu.time metric with initial data.
+func (m *metricRedisCPUTime) init() {
+	m.data.SetName("redis.cpu.time")
+	m.data.SetDescription("System CPU consumed by the Redis server in seconds since server start")
+	m.data.SetUnit("s")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisCPUTime) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, stateAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.State, pdata.NewAttributeValueString(stateAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisCPUTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisCPUTime) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisCPUTime(settings MetricSettings) metricRedisCPUTime {
+	m := metricRedisCPUTime{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisDbAvgTTL struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.db.avg_ttl metric with initial data.
+func (m *metricRedisDbAvgTTL) init() {
+	m.data.SetName("redis.db.avg_ttl")
+	m.data.SetDescription("Average keyspace keys TTL")
+	m.data.SetUnit("ms")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisDbAvgTTL) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisDbAvgTTL) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisDbAvgTTL) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisDbAvgTTL(settings MetricSettings) metricRedisDbAvgTTL {
+	m := metricRedisDbAvgTTL{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisDbExpires struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.db.expires metric with initial data.
+func (m *metricRedisDbExpires) init() {
+	m.data.SetName("redis.db.expires")
+	m.data.SetDescription("Number of keyspace keys with an expiration")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisDbExpires) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisDbExpires) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisDbExpires) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisDbExpires(settings MetricSettings) metricRedisDbExpires {
+	m := metricRedisDbExpires{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisDbKeys struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.db.keys metric with initial data.
+func (m *metricRedisDbKeys) init() {
+	m.data.SetName("redis.db.keys")
+	m.data.SetDescription("Number of keyspace keys")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisDbKeys) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisDbKeys) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisDbKeys) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisDbKeys(settings MetricSettings) metricRedisDbKeys {
+	m := metricRedisDbKeys{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisDbSubexpiry struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.db.subexpiry metric with initial data.
+func (m *metricRedisDbSubexpiry) init() {
+	m.data.SetName("redis.db.subexpiry")
+	m.data.SetDescription("Number of hash keys with fields that have an expiration (Redis 7.4+)")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisDbSubexpiry) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisDbSubexpiry) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisDbSubexpiry) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisDbSubexpiry(settings MetricSettings) metricRedisDbSubexpiry {
+	m := metricRedisDbSubexpiry{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisErrorReplies struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.error_replies metric with initial data.
+func (m *metricRedisErrorReplies) init() {
+	m.data.SetName("redis.error_replies")
+	m.data.SetDescription("Total number of error replies sent by the server")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricRedisErrorReplies) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisErrorReplies) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisErrorReplies) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisErrorReplies(settings MetricSettings) metricRedisErrorReplies {
+	m := metricRedisErrorReplies{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisErrors struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.errors metric with initial data.
+func (m *metricRedisErrors) init() {
+	m.data.SetName("redis.errors")
+	m.data.SetDescription("Number of error replies sent by the server, by error prefix")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisErrors) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, errorPrefixAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.ErrorPrefix, pdata.NewAttributeValueString(errorPrefixAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisErrors) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisErrors) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisErrors(settings MetricSettings) metricRedisErrors {
+	m := metricRedisErrors{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisKeyLength struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.key.length metric with initial data.
+func (m *metricRedisKeyLength) init() {
+	m.data.SetName("redis.key.length")
+	m.data.SetDescription("Number of elements of one of the largest sampled keys of its type, or bytes for strings")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisKeyLength) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string, keyAttributeValue string, keyTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+	dp.Attributes().Insert(A.Key, pdata.NewAttributeValueString(keyAttributeValue))
+	dp.Attributes().Insert(A.KeyType, pdata.NewAttributeValueString(keyTypeAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisKeyLength) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisKeyLength) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisKeyLength(settings MetricSettings) metricRedisKeyLength {
+	m := metricRedisKeyLength{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisKeyMemoryUsage struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.key.memory_usage metric with initial data.
+func (m *metricRedisKeyMemoryUsage) init() {
+	m.data.SetName("redis.key.memory_usage")
+	m.data.SetDescription("Memory used by one of the largest sampled keys of its type, from MEMORY USAGE")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisKeyMemoryUsage) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string, keyAttributeValue string, keyTypeAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+	dp.Attributes().Insert(A.Key, pdata.NewAttributeValueString(keyAttributeValue))
+	dp.Attributes().Insert(A.KeyType, pdata.NewAttributeValueString(keyTypeAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisKeyMemoryUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisKeyMemoryUsage) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisKeyMemoryUsage(settings MetricSettings) metricRedisKeyMemoryUsage {
+	m := metricRedisKeyMemoryUsage{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisKeysEvicted struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.keys.evicted metric with initial data.
+func (m *metricRedisKeysEvicted) init() {
+	m.data.SetName("redis.keys.evicted")
+	m.data.SetDescription("Number of evicted keys due to maxmemory limit")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricRedisKeysEvicted) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisKeysEvicted) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisKeysEvicted) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisKeysEvicted(settings MetricSettings) metricRedisKeysEvicted {
+	m := metricRedisKeysEvicted{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisKeysExpired struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.keys.expired metric with initial data.
+func (m *metricRedisKeysExpired) init() {
+	m.data.SetName("redis.keys.expired")
+	m.data.SetDescription("Total number of key expiration events")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricRedisKeysExpired) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisKeysExpired) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisKeysExpired) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisKeysExpired(settings MetricSettings) metricRedisKeysExpired {
+	m := metricRedisKeysExpired{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisKeysPatternCount struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.keys.pattern.count metric with initial data.
+func (m *metricRedisKeysPatternCount) init() {
+	m.data.SetName("redis.keys.pattern.count")
+	m.data.SetDescription("Number of keys matching the pattern, as of the last complete walk of the keyspace")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisKeysPatternCount) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string, patternAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+	dp.Attributes().Insert(A.Pattern, pdata.NewAttributeValueString(patternAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisKeysPatternCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisKeysPatternCount) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisKeysPatternCount(settings MetricSettings) metricRedisKeysPatternCount {
+	m := metricRedisKeysPatternCount{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisKeysPatternMemoryUsage struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.keys.pattern.memory_usage metric with initial data.
+func (m *metricRedisKeysPatternMemoryUsage) init() {
+	m.data.SetName("redis.keys.pattern.memory_usage")
+	m.data.SetDescription("Estimated memory used by the keys matching the pattern, as of the last complete walk of the keyspace")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisKeysPatternMemoryUsage) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string, patternAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+	dp.Attributes().Insert(A.Pattern, pdata.NewAttributeValueString(patternAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisKeysPatternMemoryUsage) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisKeysPatternMemoryUsage) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisKeysPatternMemoryUsage(settings MetricSettings) metricRedisKeysPatternMemoryUsage {
+	m := metricRedisKeysPatternMemoryUsage{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisKeyspaceHits struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.keyspace.hits metric with initial data.
+func (m *metricRedisKeyspaceHits) init() {
+	m.data.SetName("redis.keyspace.hits")
+	m.data.SetDescription("Number of successful lookup of keys in the main dictionary")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricRedisKeyspaceHits) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisKeyspaceHits) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisKeyspaceHits) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisKeyspaceHits(settings MetricSettings) metricRedisKeyspaceHits {
+	m := metricRedisKeyspaceHits{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisKeyspaceMisses struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.keyspace.misses metric with initial data.
+func (m *metricRedisKeyspaceMisses) init() {
+	m.data.SetName("redis.keyspace.misses")
+	m.data.SetDescription("Number of failed lookup of keys in the main dictionary")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricRedisKeyspaceMisses) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisKeyspaceMisses) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisKeyspaceMisses) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisKeyspaceMisses(settings MetricSettings) metricRedisKeyspaceMisses {
+	m := metricRedisKeyspaceMisses{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisLatencystatP100 struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latencystat.p100 metric with initial data.
+func (m *metricRedisLatencystatP100) init() {
+	m.data.SetName("redis.latencystat.p100")
+	m.data.SetDescription("latency stat with percentile 100")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencystatP100) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencystatP100) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencystatP100) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencystatP100(settings MetricSettings) metricRedisLatencystatP100 {
+	m := metricRedisLatencystatP100{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisLatencystatP50 struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latencystat.p50 metric with initial data.
+func (m *metricRedisLatencystatP50) init() {
+	m.data.SetName("redis.latencystat.p50")
+	m.data.SetDescription("latency stat with percentile 50")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencystatP50) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencystatP50) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencystatP50) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencystatP50(settings MetricSettings) metricRedisLatencystatP50 {
+	m := metricRedisLatencystatP50{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisLatencystatP90 struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latencystat.p90 metric with initial data.
+func (m *metricRedisLatencystatP90) init() {
+	m.data.SetName("redis.latencystat.p90")
+	m.data.SetDescription("latency stat with percentile 90")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencystatP90) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencystatP90) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencystatP90) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencystatP90(settings MetricSettings) metricRedisLatencystatP90 {
+	m := metricRedisLatencystatP90{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisLatencystatP99 struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latencystat.p99 metric with initial data.
+func (m *metricRedisLatencystatP99) init() {
+	m.data.SetName("redis.latencystat.p99")
+	m.data.SetDescription("latency stat with percentile 99")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencystatP99) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencystatP99) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencystatP99) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencystatP99(settings MetricSettings) metricRedisLatencystatP99 {
+	m := metricRedisLatencystatP99{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisLatencystatP999 struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latencystat.p99.9 metric with initial data.
+func (m *metricRedisLatencystatP999) init() {
+	m.data.SetName("redis.latencystat.p99.9")
+	m.data.SetDescription("latency stat with percentile 99.9")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencystatP999) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencystatP999) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencystatP999) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencystatP999(settings MetricSettings) metricRedisLatencystatP999 {
+	m := metricRedisLatencystatP999{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisLatencystatP9999 struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latencystat.p99.99 metric with initial data.
+func (m *metricRedisLatencystatP9999) init() {
+	m.data.SetName("redis.latencystat.p99.99")
+	m.data.SetDescription("latency stat with percentile 99.99")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricRedisLatencystatP9999) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatencystatP9999) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatencystatP9999) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatencystatP9999(settings MetricSettings) metricRedisLatencystatP9999 {
+	m := metricRedisLatencystatP9999{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisLatestFork struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.latest_fork metric with initial data.
+func (m *metricRedisLatestFork) init() {
+	m.data.SetName("redis.latest_fork")
+	m.data.SetDescription("Duration of the latest fork operation in microseconds")
+	m.data.SetUnit("us")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisLatestFork) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisLatestFork) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisLatestFork) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisLatestFork(settings MetricSettings) metricRedisLatestFork {
+	m := metricRedisLatestFork{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisMaxmemory struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.maxmemory metric with initial data.
+func (m *metricRedisMaxmemory) init() {
+	m.data.SetName("redis.maxmemory")
+	m.data.SetDescription("The value of the maxmemory configuration directive, 0 if no limit is set")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisMaxmemory) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisMaxmemory) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisMaxmemory) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisMaxmemory(settings MetricSettings) metricRedisMaxmemory {
+	m := metricRedisMaxmemory{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisMemoryFragmentationRatio struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.memory.fragmentation_ratio metric with initial data.
+func (m *metricRedisMemoryFragmentationRatio) init() {
+	m.data.SetName("redis.memory.fragmentation_ratio")
+	m.data.SetDescription("Ratio between used_memory_rss and used_memory")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisMemoryFragmentationRatio) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisMemoryFragmentationRatio) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisMemoryFragmentationRatio) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisMemoryFragmentationRatio(settings MetricSettings) metricRedisMemoryFragmentationRatio {
+	m := metricRedisMemoryFragmentationRatio{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisMemoryLua struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.memory.lua metric with initial data.
+func (m *metricRedisMemoryLua) init() {
+	m.data.SetName("redis.memory.lua")
+	m.data.SetDescription("Number of bytes used by the Lua engine")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisMemoryLua) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisMemoryLua) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisMemoryLua) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisMemoryLua(settings MetricSettings) metricRedisMemoryLua {
+	m := metricRedisMemoryLua{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisMemoryPeak struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.memory.peak metric with initial data.
+func (m *metricRedisMemoryPeak) init() {
+	m.data.SetName("redis.memory.peak")
+	m.data.SetDescription("Peak memory consumed by Redis (in bytes)")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisMemoryPeak) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisMemoryPeak) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisMemoryPeak) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisMemoryPeak(settings MetricSettings) metricRedisMemoryPeak {
+	m := metricRedisMemoryPeak{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisMemoryRss struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.memory.rss metric with initial data.
+func (m *metricRedisMemoryRss) init() {
+	m.data.SetName("redis.memory.rss")
+	m.data.SetDescription("Number of bytes that Redis allocated as seen by the operating system")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisMemoryRss) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisMemoryRss) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisMemoryRss) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisMemoryRss(settings MetricSettings) metricRedisMemoryRss {
+	m := metricRedisMemoryRss{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisMemoryTotalSystem struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.memory.total_system metric with initial data.
+func (m *metricRedisMemoryTotalSystem) init() {
+	m.data.SetName("redis.memory.total_system")
+	m.data.SetDescription("Total amount of memory of the host running Redis")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisMemoryTotalSystem) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisMemoryTotalSystem) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisMemoryTotalSystem) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisMemoryTotalSystem(settings MetricSettings) metricRedisMemoryTotalSystem {
+	m := metricRedisMemoryTotalSystem{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisMemoryUsed struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.memory.used metric with initial data.
+func (m *metricRedisMemoryUsed) init() {
+	m.data.SetName("redis.memory.used")
+	m.data.SetDescription("Total number of bytes allocated by Redis using its allocator")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisMemoryUsed) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisMemoryUsed) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisMemoryUsed) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisMemoryUsed(settings MetricSettings) metricRedisMemoryUsed {
+	m := metricRedisMemoryUsed{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisMemoryUtilization struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.memory.utilization metric with initial data.
+func (m *metricRedisMemoryUtilization) init() {
+	m.data.SetName("redis.memory.utilization")
+	m.data.SetDescription("Ratio between used_memory and maxmemory, only reported when maxmemory is set")
+	m.data.SetUnit("1")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisMemoryUtilization) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisMemoryUtilization) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisMemoryUtilization) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisMemoryUtilization(settings MetricSettings) metricRedisMemoryUtilization {
+	m := metricRedisMemoryUtilization{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisNetInput struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.net.input metric with initial data.
+func (m *metricRedisNetInput) init() {
+	m.data.SetName("redis.net.input")
+	m.data.SetDescription("The total number of bytes read from the network")
+	m.data.SetUnit("By")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+}
+
+func (m *metricRedisNetInput) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntVal(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricRedisNetInput) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricRedisNetInput) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricRedisNetInput(settings MetricSettings) metricRedisNetInput {
+	m := metricRedisNetInput{settings: settings}
+	if settings.Enabled {
+		m.data = pdata.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricRedisNetOutput struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills redis.net.output metric with initial data.
+func (m *metricRedisNetOutput) init() {
+	m.data.SetName("redis.net.output")
+	m.data.SetDescription("The total number of bytes written to the network")
+	m.data.SetUnit("By")
 	m.data.SetDataType(pdata.MetricDataTypeSum)
 	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
-	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisCommandUsec) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, commandAttributeValue string) {
+func (m *metricRedisNetOutput) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -566,18 +3257,17 @@ func (m *metricRedisCommandUsec) recordDataPoint(start pdata.Timestamp, ts pdata
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
-	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisCommandUsec) updateCapacity() {
+func (m *metricRedisNetOutput) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisCommandUsec) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisNetOutput) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -585,8 +3275,8 @@ func (m *metricRedisCommandUsec) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisCommandUsec(settings MetricSettings) metricRedisCommandUsec {
-	m := metricRedisCommandUsec{settings: settings}
+func newMetricRedisNetOutput(settings MetricSettings) metricRedisNetOutput {
+	m := metricRedisNetOutput{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -594,41 +3284,39 @@ func newMetricRedisCommandUsec(settings MetricSettings) metricRedisCommandUsec {
 	return m
 }
 
-type metricRedisCommandUsecPerCall struct {
+type metricRedisPersistenceLoading struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.command.usec_per_call metric with initial data.
-func (m *metricRedisCommandUsecPerCall) init() {
-	m.data.SetName("redis.command.usec_per_call")
-	m.data.SetDescription("Average CPU consumed per command execution")
-	m.data.SetUnit("ms")
+// init fills redis.persistence.loading metric with initial data.
+func (m *metricRedisPersistenceLoading) init() {
+	m.data.SetName("redis.persistence.loading")
+	m.data.SetDescription("Whether a dump file is being loaded, 1 if loading and 0 otherwise")
+	m.data.SetUnit("")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisCommandUsecPerCall) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+func (m *metricRedisPersistenceLoading) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleVal(val)
-	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisCommandUsecPerCall) updateCapacity() {
+func (m *metricRedisPersistenceLoading) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisCommandUsecPerCall) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisPersistenceLoading) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -636,8 +3324,8 @@ func (m *metricRedisCommandUsecPerCall) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisCommandUsecPerCall(settings MetricSettings) metricRedisCommandUsecPerCall {
-	m := metricRedisCommandUsecPerCall{settings: settings}
+func newMetricRedisPersistenceLoading(settings MetricSettings) metricRedisPersistenceLoading {
+	m := metricRedisPersistenceLoading{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -645,21 +3333,22 @@ func newMetricRedisCommandUsecPerCall(settings MetricSettings) metricRedisComman
 	return m
 }
 
-type metricRedisCommands struct {
+type metricRedisProbeLatency struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.commands metric with initial data.
-func (m *metricRedisCommands) init() {
-	m.data.SetName("redis.commands")
-	m.data.SetDescription("Number of commands processed per second")
-	m.data.SetUnit("{ops}/s")
+// init fills redis.probe.latency metric with initial data.
+func (m *metricRedisProbeLatency) init() {
+	m.data.SetName("redis.probe.latency")
+	m.data.SetDescription("Round-trip time of the last successful probe, as seen by the collector")
+	m.data.SetUnit("us")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisCommands) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisProbeLatency) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, probeAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -667,17 +3356,18 @@ func (m *metricRedisCommands) recordDataPoint(start pdata.Timestamp, ts pdata.Ti
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Probe, pdata.NewAttributeValueString(probeAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisCommands) updateCapacity() {
+func (m *metricRedisProbeLatency) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisCommands) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisProbeLatency) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -685,8 +3375,8 @@ func (m *metricRedisCommands) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisCommands(settings MetricSettings) metricRedisCommands {
-	m := metricRedisCommands{settings: settings}
+func newMetricRedisProbeLatency(settings MetricSettings) metricRedisProbeLatency {
+	m := metricRedisProbeLatency{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -694,50 +3384,50 @@ func newMetricRedisCommands(settings MetricSettings) metricRedisCommands {
 	return m
 }
 
-type metricRedisCommandsProcessed struct {
+type metricRedisProbeSuccess struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.commands.processed metric with initial data.
-func (m *metricRedisCommandsProcessed) init() {
-	m.data.SetName("redis.commands.processed")
-	m.data.SetDescription("Total number of commands processed by the server")
+// init fills redis.probe.success metric with initial data.
+func (m *metricRedisProbeSuccess) init() {
+	m.data.SetName("redis.probe.success")
+	m.data.SetDescription("Whether the last probe succeeded, 1 if it did and 0 otherwise")
 	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisCommandsProcessed) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisProbeSuccess) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, probeAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Probe, pdata.NewAttributeValueString(probeAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisCommandsProcessed) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisProbeSuccess) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisCommandsProcessed) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisProbeSuccess) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisCommandsProcessed(settings MetricSettings) metricRedisCommandsProcessed {
-	m := metricRedisCommandsProcessed{settings: settings}
+func newMetricRedisProbeSuccess(settings MetricSettings) metricRedisProbeSuccess {
+	m := metricRedisProbeSuccess{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -745,50 +3435,50 @@ func newMetricRedisCommandsProcessed(settings MetricSettings) metricRedisCommand
 	return m
 }
 
-type metricRedisConnectionsReceived struct {
+type metricRedisPubsubChannelSubscribers struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.connections.received metric with initial data.
-func (m *metricRedisConnectionsReceived) init() {
-	m.data.SetName("redis.connections.received")
-	m.data.SetDescription("Total number of connections accepted by the server")
+// init fills redis.pubsub.channel.subscribers metric with initial data.
+func (m *metricRedisPubsubChannelSubscribers) init() {
+	m.data.SetName("redis.pubsub.channel.subscribers")
+	m.data.SetDescription("Number of clients subscribed to the Pub/Sub channel, excluding pattern subscriptions")
 	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisConnectionsReceived) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisPubsubChannelSubscribers) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, channelAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Channel, pdata.NewAttributeValueString(channelAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisConnectionsReceived) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisPubsubChannelSubscribers) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisConnectionsReceived) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisPubsubChannelSubscribers) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisConnectionsReceived(settings MetricSettings) metricRedisConnectionsReceived {
-	m := metricRedisConnectionsReceived{settings: settings}
+func newMetricRedisPubsubChannelSubscribers(settings MetricSettings) metricRedisPubsubChannelSubscribers {
+	m := metricRedisPubsubChannelSubscribers{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -796,50 +3486,48 @@ func newMetricRedisConnectionsReceived(settings MetricSettings) metricRedisConne
 	return m
 }
 
-type metricRedisConnectionsRejected struct {
+type metricRedisPubsubChannels struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.connections.rejected metric with initial data.
-func (m *metricRedisConnectionsRejected) init() {
-	m.data.SetName("redis.connections.rejected")
-	m.data.SetDescription("Number of connections rejected because of maxclients limit")
+// init fills redis.pubsub.channels metric with initial data.
+func (m *metricRedisPubsubChannels) init() {
+	m.data.SetName("redis.pubsub.channels")
+	m.data.SetDescription("Number of Pub/Sub channels with at least one subscriber")
 	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
 }
 
-func (m *metricRedisConnectionsRejected) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisPubsubChannels) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisConnectionsRejected) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisPubsubChannels) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisConnectionsRejected) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisPubsubChannels) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisConnectionsRejected(settings MetricSettings) metricRedisConnectionsRejected {
-	m := metricRedisConnectionsRejected{settings: settings}
+func newMetricRedisPubsubChannels(settings MetricSettings) metricRedisPubsubChannels {
+	m := metricRedisPubsubChannels{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -847,52 +3535,48 @@ func newMetricRedisConnectionsRejected(settings MetricSettings) metricRedisConne
 	return m
 }
 
-type metricRedisCPUTime struct {
+type metricRedisPubsubPatterns struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.cpu.time metric with initial data.
-func (m *metricRedisCPUTime) init() {
-	m.data.SetName("redis.cpu.time")
-	m.data.SetDescription("System CPU consumed by the Redis server in seconds since server start")
-	m.data.SetUnit("s")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
-	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+// init fills redis.pubsub.patterns metric with initial data.
+func (m *metricRedisPubsubPatterns) init() {
+	m.data.SetName("redis.pubsub.patterns")
+	m.data.SetDescription("Number of Pub/Sub patterns with at least one subscriber")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
 }
 
-func (m *metricRedisCPUTime) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, stateAttributeValue string) {
+func (m *metricRedisPubsubPatterns) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleVal(val)
-	dp.Attributes().Insert(A.State, pdata.NewAttributeValueString(stateAttributeValue))
+	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisCPUTime) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisPubsubPatterns) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisCPUTime) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisPubsubPatterns) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisCPUTime(settings MetricSettings) metricRedisCPUTime {
-	m := metricRedisCPUTime{settings: settings}
+func newMetricRedisPubsubPatterns(settings MetricSettings) metricRedisPubsubPatterns {
+	m := metricRedisPubsubPatterns{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -900,22 +3584,22 @@ func newMetricRedisCPUTime(settings MetricSettings) metricRedisCPUTime {
 	return m
 }
 
-type metricRedisDbAvgTTL struct {
+type metricRedisPubsubShardChannelSubscribers struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.db.avg_ttl metric with initial data.
-func (m *metricRedisDbAvgTTL) init() {
-	m.data.SetName("redis.db.avg_ttl")
-	m.data.SetDescription("Average keyspace keys TTL")
-	m.data.SetUnit("ms")
+// init fills redis.pubsub.shard_channel.subscribers metric with initial data.
+func (m *metricRedisPubsubShardChannelSubscribers) init() {
+	m.data.SetName("redis.pubsub.shard_channel.subscribers")
+	m.data.SetDescription("Number of clients subscribed to the sharded Pub/Sub channel (Redis 7.0+)")
+	m.data.SetUnit("")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisDbAvgTTL) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string) {
+func (m *metricRedisPubsubShardChannelSubscribers) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, channelAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -923,18 +3607,18 @@ func (m *metricRedisDbAvgTTL) recordDataPoint(start pdata.Timestamp, ts pdata.Ti
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
-	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+	dp.Attributes().Insert(A.Channel, pdata.NewAttributeValueString(channelAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisDbAvgTTL) updateCapacity() {
+func (m *metricRedisPubsubShardChannelSubscribers) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisDbAvgTTL) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisPubsubShardChannelSubscribers) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -942,8 +3626,8 @@ func (m *metricRedisDbAvgTTL) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisDbAvgTTL(settings MetricSettings) metricRedisDbAvgTTL {
-	m := metricRedisDbAvgTTL{settings: settings}
+func newMetricRedisPubsubShardChannelSubscribers(settings MetricSettings) metricRedisPubsubShardChannelSubscribers {
+	m := metricRedisPubsubShardChannelSubscribers{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -951,22 +3635,21 @@ func newMetricRedisDbAvgTTL(settings MetricSettings) metricRedisDbAvgTTL {
 	return m
 }
 
-type metricRedisDbExpires struct {
+type metricRedisRdbBgsaveInProgress struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.db.expires metric with initial data.
-func (m *metricRedisDbExpires) init() {
-	m.data.SetName("redis.db.expires")
-	m.data.SetDescription("Number of keyspace keys with an expiration")
+// init fills redis.rdb.bgsave.in_progress metric with initial data.
+func (m *metricRedisRdbBgsaveInProgress) init() {
+	m.data.SetName("redis.rdb.bgsave.in_progress")
+	m.data.SetDescription("Whether an RDB save is in progress, 1 if saving and 0 otherwise")
 	m.data.SetUnit("")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisDbExpires) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string) {
+func (m *metricRedisRdbBgsaveInProgress) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -974,18 +3657,17 @@ func (m *metricRedisDbExpires) recordDataPoint(start pdata.Timestamp, ts pdata.T
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
-	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisDbExpires) updateCapacity() {
+func (m *metricRedisRdbBgsaveInProgress) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisDbExpires) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisRdbBgsaveInProgress) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -993,8 +3675,8 @@ func (m *metricRedisDbExpires) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisDbExpires(settings MetricSettings) metricRedisDbExpires {
-	m := metricRedisDbExpires{settings: settings}
+func newMetricRedisRdbBgsaveInProgress(settings MetricSettings) metricRedisRdbBgsaveInProgress {
+	m := metricRedisRdbBgsaveInProgress{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1002,50 +3684,50 @@ func newMetricRedisDbExpires(settings MetricSettings) metricRedisDbExpires {
 	return m
 }
 
-type metricRedisDbKeys struct {
+type metricRedisRdbChangesSinceLastSave struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.db.keys metric with initial data.
-func (m *metricRedisDbKeys) init() {
-	m.data.SetName("redis.db.keys")
-	m.data.SetDescription("Number of keyspace keys")
+// init fills redis.rdb.changes_since_last_save metric with initial data.
+func (m *metricRedisRdbChangesSinceLastSave) init() {
+	m.data.SetName("redis.rdb.changes_since_last_save")
+	m.data.SetDescription("Number of changes since the last dump")
 	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeGauge)
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
 }
 
-func (m *metricRedisDbKeys) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string) {
+func (m *metricRedisRdbChangesSinceLastSave) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp := m.data.Sum().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
-	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisDbKeys) updateCapacity() {
-	if m.data.Gauge().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Gauge().DataPoints().Len()
+func (m *metricRedisRdbChangesSinceLastSave) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisDbKeys) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+func (m *metricRedisRdbChangesSinceLastSave) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisDbKeys(settings MetricSettings) metricRedisDbKeys {
-	m := metricRedisDbKeys{settings: settings}
+func newMetricRedisRdbChangesSinceLastSave(settings MetricSettings) metricRedisRdbChangesSinceLastSave {
+	m := metricRedisRdbChangesSinceLastSave{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1053,50 +3735,48 @@ func newMetricRedisDbKeys(settings MetricSettings) metricRedisDbKeys {
 	return m
 }
 
-type metricRedisKeysEvicted struct {
+type metricRedisRdbLastBgsaveDuration struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.keys.evicted metric with initial data.
-func (m *metricRedisKeysEvicted) init() {
-	m.data.SetName("redis.keys.evicted")
-	m.data.SetDescription("Number of evicted keys due to maxmemory limit")
-	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+// init fills redis.rdb.last_bgsave.duration metric with initial data.
+func (m *metricRedisRdbLastBgsaveDuration) init() {
+	m.data.SetName("redis.rdb.last_bgsave.duration")
+	m.data.SetDescription("Duration of the last RDB save, -1 if none happened yet")
+	m.data.SetUnit("s")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
 }
 
-func (m *metricRedisKeysEvicted) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisRdbLastBgsaveDuration) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisKeysEvicted) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisRdbLastBgsaveDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisKeysEvicted) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisRdbLastBgsaveDuration) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisKeysEvicted(settings MetricSettings) metricRedisKeysEvicted {
-	m := metricRedisKeysEvicted{settings: settings}
+func newMetricRedisRdbLastBgsaveDuration(settings MetricSettings) metricRedisRdbLastBgsaveDuration {
+	m := metricRedisRdbLastBgsaveDuration{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1104,50 +3784,48 @@ func newMetricRedisKeysEvicted(settings MetricSettings) metricRedisKeysEvicted {
 	return m
 }
 
-type metricRedisKeysExpired struct {
+type metricRedisRdbLastBgsaveStatus struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.keys.expired metric with initial data.
-func (m *metricRedisKeysExpired) init() {
-	m.data.SetName("redis.keys.expired")
-	m.data.SetDescription("Total number of key expiration events")
+// init fills redis.rdb.last_bgsave.status metric with initial data.
+func (m *metricRedisRdbLastBgsaveStatus) init() {
+	m.data.SetName("redis.rdb.last_bgsave.status")
+	m.data.SetDescription("Whether the last RDB save succeeded, 1 if ok and 0 if err")
 	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
 }
 
-func (m *metricRedisKeysExpired) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisRdbLastBgsaveStatus) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisKeysExpired) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisRdbLastBgsaveStatus) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisKeysExpired) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisRdbLastBgsaveStatus) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisKeysExpired(settings MetricSettings) metricRedisKeysExpired {
-	m := metricRedisKeysExpired{settings: settings}
+func newMetricRedisRdbLastBgsaveStatus(settings MetricSettings) metricRedisRdbLastBgsaveStatus {
+	m := metricRedisRdbLastBgsaveStatus{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1155,50 +3833,48 @@ func newMetricRedisKeysExpired(settings MetricSettings) metricRedisKeysExpired {
 	return m
 }
 
-type metricRedisKeyspaceHits struct {
-	data     pdata.Metric   // data buffer for generated metric.
-	settings MetricSettings // metric settings provided by user.
-	capacity int            // max observed number of data points added to the metric.
-}
-
-// init fills redis.keyspace.hits metric with initial data.
-func (m *metricRedisKeyspaceHits) init() {
-	m.data.SetName("redis.keyspace.hits")
-	m.data.SetDescription("Number of successful lookup of keys in the main dictionary")
-	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+type metricRedisRdbLastSaveTime struct {
+	data     pdata.Metric   // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
 }
 
-func (m *metricRedisKeyspaceHits) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+// init fills redis.rdb.last_save.time metric with initial data.
+func (m *metricRedisRdbLastSaveTime) init() {
+	m.data.SetName("redis.rdb.last_save.time")
+	m.data.SetDescription("Unix time of the last successful RDB save")
+	m.data.SetUnit("s")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+}
+
+func (m *metricRedisRdbLastSaveTime) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisKeyspaceHits) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisRdbLastSaveTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisKeyspaceHits) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisRdbLastSaveTime) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisKeyspaceHits(settings MetricSettings) metricRedisKeyspaceHits {
-	m := metricRedisKeyspaceHits{settings: settings}
+func newMetricRedisRdbLastSaveTime(settings MetricSettings) metricRedisRdbLastSaveTime {
+	m := metricRedisRdbLastSaveTime{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1206,50 +3882,48 @@ func newMetricRedisKeyspaceHits(settings MetricSettings) metricRedisKeyspaceHits
 	return m
 }
 
-type metricRedisKeyspaceMisses struct {
+type metricRedisReplicationBacklogFirstByteOffset struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.keyspace.misses metric with initial data.
-func (m *metricRedisKeyspaceMisses) init() {
-	m.data.SetName("redis.keyspace.misses")
-	m.data.SetDescription("Number of failed lookup of keys in the main dictionary")
+// init fills redis.replication.backlog_first_byte_offset metric with initial data.
+func (m *metricRedisReplicationBacklogFirstByteOffset) init() {
+	m.data.SetName("redis.replication.backlog_first_byte_offset")
+	m.data.SetDescription("The master offset of the replication backlog buffer")
 	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
 }
 
-func (m *metricRedisKeyspaceMisses) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisReplicationBacklogFirstByteOffset) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisKeyspaceMisses) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisReplicationBacklogFirstByteOffset) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisKeyspaceMisses) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisReplicationBacklogFirstByteOffset) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisKeyspaceMisses(settings MetricSettings) metricRedisKeyspaceMisses {
-	m := metricRedisKeyspaceMisses{settings: settings}
+func newMetricRedisReplicationBacklogFirstByteOffset(settings MetricSettings) metricRedisReplicationBacklogFirstByteOffset {
+	m := metricRedisReplicationBacklogFirstByteOffset{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1257,22 +3931,22 @@ func newMetricRedisKeyspaceMisses(settings MetricSettings) metricRedisKeyspaceMi
 	return m
 }
 
-type metricRedisLatencystatP100 struct {
+type metricRedisReplicationHeartbeatLag struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.latencystat.p100 metric with initial data.
-func (m *metricRedisLatencystatP100) init() {
-	m.data.SetName("redis.latencystat.p100")
-	m.data.SetDescription("latency stat with percentile 100")
-	m.data.SetUnit("")
+// init fills redis.replication.heartbeat_lag metric with initial data.
+func (m *metricRedisReplicationHeartbeatLag) init() {
+	m.data.SetName("redis.replication.heartbeat_lag")
+	m.data.SetDescription("Number of seconds since the oldest heartbeat written to the primary that the replica has not applied")
+	m.data.SetUnit("s")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisLatencystatP100) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+func (m *metricRedisReplicationHeartbeatLag) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, replicaAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -1280,18 +3954,18 @@ func (m *metricRedisLatencystatP100) recordDataPoint(start pdata.Timestamp, ts p
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetDoubleVal(val)
-	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+	dp.Attributes().Insert(A.Replica, pdata.NewAttributeValueString(replicaAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisLatencystatP100) updateCapacity() {
+func (m *metricRedisReplicationHeartbeatLag) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisLatencystatP100) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisReplicationHeartbeatLag) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1299,8 +3973,8 @@ func (m *metricRedisLatencystatP100) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisLatencystatP100(settings MetricSettings) metricRedisLatencystatP100 {
-	m := metricRedisLatencystatP100{settings: settings}
+func newMetricRedisReplicationHeartbeatLag(settings MetricSettings) metricRedisReplicationHeartbeatLag {
+	m := metricRedisReplicationHeartbeatLag{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1308,41 +3982,39 @@ func newMetricRedisLatencystatP100(settings MetricSettings) metricRedisLatencyst
 	return m
 }
 
-type metricRedisLatencystatP50 struct {
+type metricRedisReplicationMasterLinkDownSince struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.latencystat.p50 metric with initial data.
-func (m *metricRedisLatencystatP50) init() {
-	m.data.SetName("redis.latencystat.p50")
-	m.data.SetDescription("latency stat with percentile 50")
-	m.data.SetUnit("")
+// init fills redis.replication.master_link.down_since metric with initial data.
+func (m *metricRedisReplicationMasterLinkDownSince) init() {
+	m.data.SetName("redis.replication.master_link.down_since")
+	m.data.SetDescription("Number of seconds the replica's link to its primary has been down")
+	m.data.SetUnit("s")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisLatencystatP50) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+func (m *metricRedisReplicationMasterLinkDownSince) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleVal(val)
-	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisLatencystatP50) updateCapacity() {
+func (m *metricRedisReplicationMasterLinkDownSince) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisLatencystatP50) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisReplicationMasterLinkDownSince) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1350,8 +4022,8 @@ func (m *metricRedisLatencystatP50) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisLatencystatP50(settings MetricSettings) metricRedisLatencystatP50 {
-	m := metricRedisLatencystatP50{settings: settings}
+func newMetricRedisReplicationMasterLinkDownSince(settings MetricSettings) metricRedisReplicationMasterLinkDownSince {
+	m := metricRedisReplicationMasterLinkDownSince{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1359,41 +4031,39 @@ func newMetricRedisLatencystatP50(settings MetricSettings) metricRedisLatencysta
 	return m
 }
 
-type metricRedisLatencystatP90 struct {
+type metricRedisReplicationMasterLinkLastIo struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.latencystat.p90 metric with initial data.
-func (m *metricRedisLatencystatP90) init() {
-	m.data.SetName("redis.latencystat.p90")
-	m.data.SetDescription("latency stat with percentile 90")
-	m.data.SetUnit("")
+// init fills redis.replication.master_link.last_io metric with initial data.
+func (m *metricRedisReplicationMasterLinkLastIo) init() {
+	m.data.SetName("redis.replication.master_link.last_io")
+	m.data.SetDescription("Number of seconds since the replica last interacted with its primary")
+	m.data.SetUnit("s")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisLatencystatP90) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+func (m *metricRedisReplicationMasterLinkLastIo) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleVal(val)
-	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisLatencystatP90) updateCapacity() {
+func (m *metricRedisReplicationMasterLinkLastIo) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisLatencystatP90) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisReplicationMasterLinkLastIo) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1401,8 +4071,8 @@ func (m *metricRedisLatencystatP90) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisLatencystatP90(settings MetricSettings) metricRedisLatencystatP90 {
-	m := metricRedisLatencystatP90{settings: settings}
+func newMetricRedisReplicationMasterLinkLastIo(settings MetricSettings) metricRedisReplicationMasterLinkLastIo {
+	m := metricRedisReplicationMasterLinkLastIo{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1410,41 +4080,39 @@ func newMetricRedisLatencystatP90(settings MetricSettings) metricRedisLatencysta
 	return m
 }
 
-type metricRedisLatencystatP99 struct {
+type metricRedisReplicationMasterLinkSyncInProgress struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.latencystat.p99 metric with initial data.
-func (m *metricRedisLatencystatP99) init() {
-	m.data.SetName("redis.latencystat.p99")
-	m.data.SetDescription("latency stat with percentile 99")
+// init fills redis.replication.master_link.sync_in_progress metric with initial data.
+func (m *metricRedisReplicationMasterLinkSyncInProgress) init() {
+	m.data.SetName("redis.replication.master_link.sync_in_progress")
+	m.data.SetDescription("Whether the primary is syncing to the replica, 1 if syncing and 0 otherwise")
 	m.data.SetUnit("")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisLatencystatP99) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+func (m *metricRedisReplicationMasterLinkSyncInProgress) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleVal(val)
-	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisLatencystatP99) updateCapacity() {
+func (m *metricRedisReplicationMasterLinkSyncInProgress) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisLatencystatP99) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisReplicationMasterLinkSyncInProgress) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1452,8 +4120,8 @@ func (m *metricRedisLatencystatP99) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisLatencystatP99(settings MetricSettings) metricRedisLatencystatP99 {
-	m := metricRedisLatencystatP99{settings: settings}
+func newMetricRedisReplicationMasterLinkSyncInProgress(settings MetricSettings) metricRedisReplicationMasterLinkSyncInProgress {
+	m := metricRedisReplicationMasterLinkSyncInProgress{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1461,41 +4129,39 @@ func newMetricRedisLatencystatP99(settings MetricSettings) metricRedisLatencysta
 	return m
 }
 
-type metricRedisLatencystatP999 struct {
+type metricRedisReplicationMasterLinkUp struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.latencystat.p99.9 metric with initial data.
-func (m *metricRedisLatencystatP999) init() {
-	m.data.SetName("redis.latencystat.p99.9")
-	m.data.SetDescription("latency stat with percentile 99.9")
+// init fills redis.replication.master_link.up metric with initial data.
+func (m *metricRedisReplicationMasterLinkUp) init() {
+	m.data.SetName("redis.replication.master_link.up")
+	m.data.SetDescription("Whether the replica's link to its primary is up, 1 if up and 0 if down")
 	m.data.SetUnit("")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisLatencystatP999) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+func (m *metricRedisReplicationMasterLinkUp) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleVal(val)
-	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisLatencystatP999) updateCapacity() {
+func (m *metricRedisReplicationMasterLinkUp) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisLatencystatP999) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisReplicationMasterLinkUp) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1503,8 +4169,8 @@ func (m *metricRedisLatencystatP999) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisLatencystatP999(settings MetricSettings) metricRedisLatencystatP999 {
-	m := metricRedisLatencystatP999{settings: settings}
+func newMetricRedisReplicationMasterLinkUp(settings MetricSettings) metricRedisReplicationMasterLinkUp {
+	m := metricRedisReplicationMasterLinkUp{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1512,41 +4178,39 @@ func newMetricRedisLatencystatP999(settings MetricSettings) metricRedisLatencyst
 	return m
 }
 
-type metricRedisLatencystatP9999 struct {
+type metricRedisReplicationOffset struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.latencystat.p99.99 metric with initial data.
-func (m *metricRedisLatencystatP9999) init() {
-	m.data.SetName("redis.latencystat.p99.99")
-	m.data.SetDescription("latency stat with percentile 99.99")
+// init fills redis.replication.offset metric with initial data.
+func (m *metricRedisReplicationOffset) init() {
+	m.data.SetName("redis.replication.offset")
+	m.data.SetDescription("The server's current replication offset")
 	m.data.SetUnit("")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisLatencystatP9999) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64, commandAttributeValue string) {
+func (m *metricRedisReplicationOffset) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleVal(val)
-	dp.Attributes().Insert(A.Command, pdata.NewAttributeValueString(commandAttributeValue))
+	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisLatencystatP9999) updateCapacity() {
+func (m *metricRedisReplicationOffset) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisLatencystatP9999) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisReplicationOffset) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1554,8 +4218,8 @@ func (m *metricRedisLatencystatP9999) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisLatencystatP9999(settings MetricSettings) metricRedisLatencystatP9999 {
-	m := metricRedisLatencystatP9999{settings: settings}
+func newMetricRedisReplicationOffset(settings MetricSettings) metricRedisReplicationOffset {
+	m := metricRedisReplicationOffset{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1563,21 +4227,22 @@ func newMetricRedisLatencystatP9999(settings MetricSettings) metricRedisLatencys
 	return m
 }
 
-type metricRedisLatestFork struct {
+type metricRedisReplicationReplicaLag struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.latest_fork metric with initial data.
-func (m *metricRedisLatestFork) init() {
-	m.data.SetName("redis.latest_fork")
-	m.data.SetDescription("Duration of the latest fork operation in microseconds")
-	m.data.SetUnit("us")
+// init fills redis.replication.replica.lag metric with initial data.
+func (m *metricRedisReplicationReplicaLag) init() {
+	m.data.SetName("redis.replication.replica.lag")
+	m.data.SetDescription("Number of seconds since the replica last acknowledged the replication stream")
+	m.data.SetUnit("s")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisLatestFork) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisReplicationReplicaLag) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, replicaAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -1585,17 +4250,18 @@ func (m *metricRedisLatestFork) recordDataPoint(start pdata.Timestamp, ts pdata.
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Replica, pdata.NewAttributeValueString(replicaAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisLatestFork) updateCapacity() {
+func (m *metricRedisReplicationReplicaLag) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisLatestFork) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisReplicationReplicaLag) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1603,8 +4269,8 @@ func (m *metricRedisLatestFork) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisLatestFork(settings MetricSettings) metricRedisLatestFork {
-	m := metricRedisLatestFork{settings: settings}
+func newMetricRedisReplicationReplicaLag(settings MetricSettings) metricRedisReplicationReplicaLag {
+	m := metricRedisReplicationReplicaLag{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1612,39 +4278,41 @@ func newMetricRedisLatestFork(settings MetricSettings) metricRedisLatestFork {
 	return m
 }
 
-type metricRedisMemoryFragmentationRatio struct {
+type metricRedisReplicationReplicaOffsetDelta struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.memory.fragmentation_ratio metric with initial data.
-func (m *metricRedisMemoryFragmentationRatio) init() {
-	m.data.SetName("redis.memory.fragmentation_ratio")
-	m.data.SetDescription("Ratio between used_memory_rss and used_memory")
-	m.data.SetUnit("")
+// init fills redis.replication.replica.offset_delta metric with initial data.
+func (m *metricRedisReplicationReplicaOffsetDelta) init() {
+	m.data.SetName("redis.replication.replica.offset_delta")
+	m.data.SetDescription("Number of bytes of the replication stream the replica has not acknowledged yet")
+	m.data.SetUnit("By")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisMemoryFragmentationRatio) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val float64) {
+func (m *metricRedisReplicationReplicaOffsetDelta) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, replicaAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
 	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	dp.SetDoubleVal(val)
+	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Replica, pdata.NewAttributeValueString(replicaAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisMemoryFragmentationRatio) updateCapacity() {
+func (m *metricRedisReplicationReplicaOffsetDelta) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisMemoryFragmentationRatio) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisReplicationReplicaOffsetDelta) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1652,8 +4320,8 @@ func (m *metricRedisMemoryFragmentationRatio) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisMemoryFragmentationRatio(settings MetricSettings) metricRedisMemoryFragmentationRatio {
-	m := metricRedisMemoryFragmentationRatio{settings: settings}
+func newMetricRedisReplicationReplicaOffsetDelta(settings MetricSettings) metricRedisReplicationReplicaOffsetDelta {
+	m := metricRedisReplicationReplicaOffsetDelta{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1661,21 +4329,22 @@ func newMetricRedisMemoryFragmentationRatio(settings MetricSettings) metricRedis
 	return m
 }
 
-type metricRedisMemoryLua struct {
+type metricRedisReplicationReplicaState struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.memory.lua metric with initial data.
-func (m *metricRedisMemoryLua) init() {
-	m.data.SetName("redis.memory.lua")
-	m.data.SetDescription("Number of bytes used by the Lua engine")
-	m.data.SetUnit("By")
+// init fills redis.replication.replica.state metric with initial data.
+func (m *metricRedisReplicationReplicaState) init() {
+	m.data.SetName("redis.replication.replica.state")
+	m.data.SetDescription("Replication state of the replica, 1 for the current state")
+	m.data.SetUnit("")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisMemoryLua) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisReplicationReplicaState) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, replicaAttributeValue string, replicaStateAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -1683,17 +4352,19 @@ func (m *metricRedisMemoryLua) recordDataPoint(start pdata.Timestamp, ts pdata.T
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Replica, pdata.NewAttributeValueString(replicaAttributeValue))
+	dp.Attributes().Insert(A.ReplicaState, pdata.NewAttributeValueString(replicaStateAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisMemoryLua) updateCapacity() {
+func (m *metricRedisReplicationReplicaState) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisMemoryLua) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisReplicationReplicaState) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1701,8 +4372,8 @@ func (m *metricRedisMemoryLua) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisMemoryLua(settings MetricSettings) metricRedisMemoryLua {
-	m := metricRedisMemoryLua{settings: settings}
+func newMetricRedisReplicationReplicaState(settings MetricSettings) metricRedisReplicationReplicaState {
+	m := metricRedisReplicationReplicaState{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1710,48 +4381,52 @@ func newMetricRedisMemoryLua(settings MetricSettings) metricRedisMemoryLua {
 	return m
 }
 
-type metricRedisMemoryPeak struct {
+type metricRedisScrapeError struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.memory.peak metric with initial data.
-func (m *metricRedisMemoryPeak) init() {
-	m.data.SetName("redis.memory.peak")
-	m.data.SetDescription("Peak memory consumed by Redis (in bytes)")
-	m.data.SetUnit("By")
-	m.data.SetDataType(pdata.MetricDataTypeGauge)
+// init fills redis.scrape.error metric with initial data.
+func (m *metricRedisScrapeError) init() {
+	m.data.SetName("redis.scrape.error")
+	m.data.SetDescription("Number of failed scrapes of the server since the collector started, per category of error")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisMemoryPeak) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisScrapeError) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, categoryAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp := m.data.Sum().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Category, pdata.NewAttributeValueString(categoryAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisMemoryPeak) updateCapacity() {
-	if m.data.Gauge().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Gauge().DataPoints().Len()
+func (m *metricRedisScrapeError) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisMemoryPeak) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+func (m *metricRedisScrapeError) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisMemoryPeak(settings MetricSettings) metricRedisMemoryPeak {
-	m := metricRedisMemoryPeak{settings: settings}
+func newMetricRedisScrapeError(settings MetricSettings) metricRedisScrapeError {
+	m := metricRedisScrapeError{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1759,48 +4434,50 @@ func newMetricRedisMemoryPeak(settings MetricSettings) metricRedisMemoryPeak {
 	return m
 }
 
-type metricRedisMemoryRss struct {
+type metricRedisSlavesConnected struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.memory.rss metric with initial data.
-func (m *metricRedisMemoryRss) init() {
-	m.data.SetName("redis.memory.rss")
-	m.data.SetDescription("Number of bytes that Redis allocated as seen by the operating system")
-	m.data.SetUnit("By")
-	m.data.SetDataType(pdata.MetricDataTypeGauge)
+// init fills redis.slaves.connected metric with initial data.
+func (m *metricRedisSlavesConnected) init() {
+	m.data.SetName("redis.slaves.connected")
+	m.data.SetDescription("Number of connected replicas")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeSum)
+	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
 }
 
-func (m *metricRedisMemoryRss) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisSlavesConnected) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp := m.data.Sum().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisMemoryRss) updateCapacity() {
-	if m.data.Gauge().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Gauge().DataPoints().Len()
+func (m *metricRedisSlavesConnected) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisMemoryRss) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+func (m *metricRedisSlavesConnected) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisMemoryRss(settings MetricSettings) metricRedisMemoryRss {
-	m := metricRedisMemoryRss{settings: settings}
+func newMetricRedisSlavesConnected(settings MetricSettings) metricRedisSlavesConnected {
+	m := metricRedisSlavesConnected{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1808,21 +4485,22 @@ func newMetricRedisMemoryRss(settings MetricSettings) metricRedisMemoryRss {
 	return m
 }
 
-type metricRedisMemoryUsed struct {
+type metricRedisStreamConsumerIdle struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.memory.used metric with initial data.
-func (m *metricRedisMemoryUsed) init() {
-	m.data.SetName("redis.memory.used")
-	m.data.SetDescription("Total number of bytes allocated by Redis using its allocator")
-	m.data.SetUnit("By")
+// init fills redis.stream.consumer.idle metric with initial data.
+func (m *metricRedisStreamConsumerIdle) init() {
+	m.data.SetName("redis.stream.consumer.idle")
+	m.data.SetDescription("Time since the consumer last interacted with the server")
+	m.data.SetUnit("ms")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisMemoryUsed) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisStreamConsumerIdle) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string, streamAttributeValue string, groupAttributeValue string, consumerAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -1830,17 +4508,21 @@ func (m *metricRedisMemoryUsed) recordDataPoint(start pdata.Timestamp, ts pdata.
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+	dp.Attributes().Insert(A.Stream, pdata.NewAttributeValueString(streamAttributeValue))
+	dp.Attributes().Insert(A.Group, pdata.NewAttributeValueString(groupAttributeValue))
+	dp.Attributes().Insert(A.Consumer, pdata.NewAttributeValueString(consumerAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisMemoryUsed) updateCapacity() {
+func (m *metricRedisStreamConsumerIdle) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisMemoryUsed) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisStreamConsumerIdle) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -1848,8 +4530,8 @@ func (m *metricRedisMemoryUsed) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisMemoryUsed(settings MetricSettings) metricRedisMemoryUsed {
-	m := metricRedisMemoryUsed{settings: settings}
+func newMetricRedisStreamConsumerIdle(settings MetricSettings) metricRedisStreamConsumerIdle {
+	m := metricRedisStreamConsumerIdle{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1857,50 +4539,53 @@ func newMetricRedisMemoryUsed(settings MetricSettings) metricRedisMemoryUsed {
 	return m
 }
 
-type metricRedisNetInput struct {
+type metricRedisStreamConsumerPending struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.net.input metric with initial data.
-func (m *metricRedisNetInput) init() {
-	m.data.SetName("redis.net.input")
-	m.data.SetDescription("The total number of bytes read from the network")
-	m.data.SetUnit("By")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+// init fills redis.stream.consumer.pending metric with initial data.
+func (m *metricRedisStreamConsumerPending) init() {
+	m.data.SetName("redis.stream.consumer.pending")
+	m.data.SetDescription("Number of entries delivered to the consumer but not yet acknowledged")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisNetInput) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisStreamConsumerPending) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string, streamAttributeValue string, groupAttributeValue string, consumerAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+	dp.Attributes().Insert(A.Stream, pdata.NewAttributeValueString(streamAttributeValue))
+	dp.Attributes().Insert(A.Group, pdata.NewAttributeValueString(groupAttributeValue))
+	dp.Attributes().Insert(A.Consumer, pdata.NewAttributeValueString(consumerAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisNetInput) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisStreamConsumerPending) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisNetInput) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisStreamConsumerPending) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisNetInput(settings MetricSettings) metricRedisNetInput {
-	m := metricRedisNetInput{settings: settings}
+func newMetricRedisStreamConsumerPending(settings MetricSettings) metricRedisStreamConsumerPending {
+	m := metricRedisStreamConsumerPending{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1908,50 +4593,52 @@ func newMetricRedisNetInput(settings MetricSettings) metricRedisNetInput {
 	return m
 }
 
-type metricRedisNetOutput struct {
+type metricRedisStreamGroupLag struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.net.output metric with initial data.
-func (m *metricRedisNetOutput) init() {
-	m.data.SetName("redis.net.output")
-	m.data.SetDescription("The total number of bytes written to the network")
-	m.data.SetUnit("By")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+// init fills redis.stream.group.lag metric with initial data.
+func (m *metricRedisStreamGroupLag) init() {
+	m.data.SetName("redis.stream.group.lag")
+	m.data.SetDescription("Number of entries of the stream not yet delivered to the group (Redis 7.0+), only reported when the server can compute it")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisNetOutput) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisStreamGroupLag) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string, streamAttributeValue string, groupAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+	dp.Attributes().Insert(A.Stream, pdata.NewAttributeValueString(streamAttributeValue))
+	dp.Attributes().Insert(A.Group, pdata.NewAttributeValueString(groupAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisNetOutput) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisStreamGroupLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisNetOutput) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisStreamGroupLag) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisNetOutput(settings MetricSettings) metricRedisNetOutput {
-	m := metricRedisNetOutput{settings: settings}
+func newMetricRedisStreamGroupLag(settings MetricSettings) metricRedisStreamGroupLag {
+	m := metricRedisStreamGroupLag{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -1959,50 +4646,52 @@ func newMetricRedisNetOutput(settings MetricSettings) metricRedisNetOutput {
 	return m
 }
 
-type metricRedisRdbChangesSinceLastSave struct {
+type metricRedisStreamGroupLastDeliveredAge struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.rdb.changes_since_last_save metric with initial data.
-func (m *metricRedisRdbChangesSinceLastSave) init() {
-	m.data.SetName("redis.rdb.changes_since_last_save")
-	m.data.SetDescription("Number of changes since the last dump")
-	m.data.SetUnit("")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(false)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+// init fills redis.stream.group.last_delivered_age metric with initial data.
+func (m *metricRedisStreamGroupLastDeliveredAge) init() {
+	m.data.SetName("redis.stream.group.last_delivered_age")
+	m.data.SetDescription("Time since the entry last delivered to the group was added to the stream")
+	m.data.SetUnit("ms")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisRdbChangesSinceLastSave) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisStreamGroupLastDeliveredAge) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string, streamAttributeValue string, groupAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+	dp.Attributes().Insert(A.Stream, pdata.NewAttributeValueString(streamAttributeValue))
+	dp.Attributes().Insert(A.Group, pdata.NewAttributeValueString(groupAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisRdbChangesSinceLastSave) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisStreamGroupLastDeliveredAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisRdbChangesSinceLastSave) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisStreamGroupLastDeliveredAge) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisRdbChangesSinceLastSave(settings MetricSettings) metricRedisRdbChangesSinceLastSave {
-	m := metricRedisRdbChangesSinceLastSave{settings: settings}
+func newMetricRedisStreamGroupLastDeliveredAge(settings MetricSettings) metricRedisStreamGroupLastDeliveredAge {
+	m := metricRedisStreamGroupLastDeliveredAge{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -2010,21 +4699,22 @@ func newMetricRedisRdbChangesSinceLastSave(settings MetricSettings) metricRedisR
 	return m
 }
 
-type metricRedisReplicationBacklogFirstByteOffset struct {
+type metricRedisStreamGroupPending struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.replication.backlog_first_byte_offset metric with initial data.
-func (m *metricRedisReplicationBacklogFirstByteOffset) init() {
-	m.data.SetName("redis.replication.backlog_first_byte_offset")
-	m.data.SetDescription("The master offset of the replication backlog buffer")
+// init fills redis.stream.group.pending metric with initial data.
+func (m *metricRedisStreamGroupPending) init() {
+	m.data.SetName("redis.stream.group.pending")
+	m.data.SetDescription("Number of entries delivered to the consumers of the group but not yet acknowledged")
 	m.data.SetUnit("")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisReplicationBacklogFirstByteOffset) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisStreamGroupPending) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string, streamAttributeValue string, groupAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -2032,17 +4722,20 @@ func (m *metricRedisReplicationBacklogFirstByteOffset) recordDataPoint(start pda
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+	dp.Attributes().Insert(A.Stream, pdata.NewAttributeValueString(streamAttributeValue))
+	dp.Attributes().Insert(A.Group, pdata.NewAttributeValueString(groupAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisReplicationBacklogFirstByteOffset) updateCapacity() {
+func (m *metricRedisStreamGroupPending) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisReplicationBacklogFirstByteOffset) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisStreamGroupPending) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -2050,8 +4743,8 @@ func (m *metricRedisReplicationBacklogFirstByteOffset) emit(metrics pdata.Metric
 	}
 }
 
-func newMetricRedisReplicationBacklogFirstByteOffset(settings MetricSettings) metricRedisReplicationBacklogFirstByteOffset {
-	m := metricRedisReplicationBacklogFirstByteOffset{settings: settings}
+func newMetricRedisStreamGroupPending(settings MetricSettings) metricRedisStreamGroupPending {
+	m := metricRedisStreamGroupPending{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -2059,21 +4752,21 @@ func newMetricRedisReplicationBacklogFirstByteOffset(settings MetricSettings) me
 	return m
 }
 
-type metricRedisReplicationOffset struct {
+type metricRedisUp struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.replication.offset metric with initial data.
-func (m *metricRedisReplicationOffset) init() {
-	m.data.SetName("redis.replication.offset")
-	m.data.SetDescription("The server's current replication offset")
+// init fills redis.up metric with initial data.
+func (m *metricRedisUp) init() {
+	m.data.SetName("redis.up")
+	m.data.SetDescription("Whether the server could be scraped, 1 if INFO succeeded and 0 otherwise")
 	m.data.SetUnit("")
 	m.data.SetDataType(pdata.MetricDataTypeGauge)
 }
 
-func (m *metricRedisReplicationOffset) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisUp) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -2084,14 +4777,14 @@ func (m *metricRedisReplicationOffset) recordDataPoint(start pdata.Timestamp, ts
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisReplicationOffset) updateCapacity() {
+func (m *metricRedisUp) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisReplicationOffset) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisUp) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -2099,8 +4792,8 @@ func (m *metricRedisReplicationOffset) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisReplicationOffset(settings MetricSettings) metricRedisReplicationOffset {
-	m := metricRedisReplicationOffset{settings: settings}
+func newMetricRedisUp(settings MetricSettings) metricRedisUp {
+	m := metricRedisUp{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -2108,23 +4801,23 @@ func newMetricRedisReplicationOffset(settings MetricSettings) metricRedisReplica
 	return m
 }
 
-type metricRedisSlavesConnected struct {
+type metricRedisUptime struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.slaves.connected metric with initial data.
-func (m *metricRedisSlavesConnected) init() {
-	m.data.SetName("redis.slaves.connected")
-	m.data.SetDescription("Number of connected replicas")
-	m.data.SetUnit("")
+// init fills redis.uptime metric with initial data.
+func (m *metricRedisUptime) init() {
+	m.data.SetName("redis.uptime")
+	m.data.SetDescription("Number of seconds since Redis server start")
+	m.data.SetUnit("s")
 	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(false)
+	m.data.Sum().SetIsMonotonic(true)
 	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
 }
 
-func (m *metricRedisSlavesConnected) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisUptime) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
 	if !m.settings.Enabled {
 		return
 	}
@@ -2135,14 +4828,14 @@ func (m *metricRedisSlavesConnected) recordDataPoint(start pdata.Timestamp, ts p
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisSlavesConnected) updateCapacity() {
+func (m *metricRedisUptime) updateCapacity() {
 	if m.data.Sum().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisSlavesConnected) emit(metrics pdata.MetricSlice) {
+func (m *metricRedisUptime) emit(metrics pdata.MetricSlice) {
 	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
@@ -2150,8 +4843,8 @@ func (m *metricRedisSlavesConnected) emit(metrics pdata.MetricSlice) {
 	}
 }
 
-func newMetricRedisSlavesConnected(settings MetricSettings) metricRedisSlavesConnected {
-	m := metricRedisSlavesConnected{settings: settings}
+func newMetricRedisUptime(settings MetricSettings) metricRedisUptime {
+	m := metricRedisUptime{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -2159,50 +4852,52 @@ func newMetricRedisSlavesConnected(settings MetricSettings) metricRedisSlavesCon
 	return m
 }
 
-type metricRedisUptime struct {
+type metricRedisWatchedKeyLength struct {
 	data     pdata.Metric   // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
 	capacity int            // max observed number of data points added to the metric.
 }
 
-// init fills redis.uptime metric with initial data.
-func (m *metricRedisUptime) init() {
-	m.data.SetName("redis.uptime")
-	m.data.SetDescription("Number of seconds since Redis server start")
-	m.data.SetUnit("s")
-	m.data.SetDataType(pdata.MetricDataTypeSum)
-	m.data.Sum().SetIsMonotonic(true)
-	m.data.Sum().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+// init fills redis.watched_key.length metric with initial data.
+func (m *metricRedisWatchedKeyLength) init() {
+	m.data.SetName("redis.watched_key.length")
+	m.data.SetDescription("Number of elements of a watched key, from LLEN, XLEN, ZCARD, SCARD or HLEN depending on its type, 0 if it does not exist")
+	m.data.SetUnit("")
+	m.data.SetDataType(pdata.MetricDataTypeGauge)
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 }
 
-func (m *metricRedisUptime) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64) {
+func (m *metricRedisWatchedKeyLength) recordDataPoint(start pdata.Timestamp, ts pdata.Timestamp, val int64, dbAttributeValue string, keyAttributeValue string, keyTypeAttributeValue string) {
 	if !m.settings.Enabled {
 		return
 	}
-	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
 	dp.SetIntVal(val)
+	dp.Attributes().Insert(A.Db, pdata.NewAttributeValueString(dbAttributeValue))
+	dp.Attributes().Insert(A.Key, pdata.NewAttributeValueString(keyAttributeValue))
+	dp.Attributes().Insert(A.KeyType, pdata.NewAttributeValueString(keyTypeAttributeValue))
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricRedisUptime) updateCapacity() {
-	if m.data.Sum().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Sum().DataPoints().Len()
+func (m *metricRedisWatchedKeyLength) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricRedisUptime) emit(metrics pdata.MetricSlice) {
-	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+func (m *metricRedisWatchedKeyLength) emit(metrics pdata.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		m.updateCapacity()
 		m.data.MoveTo(metrics.AppendEmpty())
 		m.init()
 	}
 }
 
-func newMetricRedisUptime(settings MetricSettings) metricRedisUptime {
-	m := metricRedisUptime{settings: settings}
+func newMetricRedisWatchedKeyLength(settings MetricSettings) metricRedisWatchedKeyLength {
+	m := metricRedisWatchedKeyLength{settings: settings}
 	if settings.Enabled {
 		m.data = pdata.NewMetric()
 		m.init()
@@ -2213,47 +4908,96 @@ func newMetricRedisUptime(settings MetricSettings) metricRedisUptime {
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
-	startTime                                    pdata.Timestamp
-	metricRedisClientsBlocked                    metricRedisClientsBlocked
-	metricRedisClientsConnected                  metricRedisClientsConnected
-	metricRedisClientsMaxInputBuffer             metricRedisClientsMaxInputBuffer
-	metricRedisClientsMaxOutputBuffer            metricRedisClientsMaxOutputBuffer
-	metricRedisCommandCalls                      metricRedisCommandCalls
-	metricRedisCommandFailedCalls                metricRedisCommandFailedCalls
-	metricRedisCommandRejectedCalls              metricRedisCommandRejectedCalls
-	metricRedisCommandUsec                       metricRedisCommandUsec
-	metricRedisCommandUsecPerCall                metricRedisCommandUsecPerCall
-	metricRedisCommands                          metricRedisCommands
-	metricRedisCommandsProcessed                 metricRedisCommandsProcessed
-	metricRedisConnectionsReceived               metricRedisConnectionsReceived
-	metricRedisConnectionsRejected               metricRedisConnectionsRejected
-	metricRedisCPUTime                           metricRedisCPUTime
-	metricRedisDbAvgTTL                          metricRedisDbAvgTTL
-	metricRedisDbExpires                         metricRedisDbExpires
-	metricRedisDbKeys                            metricRedisDbKeys
-	metricRedisKeysEvicted                       metricRedisKeysEvicted
-	metricRedisKeysExpired                       metricRedisKeysExpired
-	metricRedisKeyspaceHits                      metricRedisKeyspaceHits
-	metricRedisKeyspaceMisses                    metricRedisKeyspaceMisses
-	metricRedisLatencystatP100                   metricRedisLatencystatP100
-	metricRedisLatencystatP50                    metricRedisLatencystatP50
-	metricRedisLatencystatP90                    metricRedisLatencystatP90
-	metricRedisLatencystatP99                    metricRedisLatencystatP99
-	metricRedisLatencystatP999                   metricRedisLatencystatP999
-	metricRedisLatencystatP9999                  metricRedisLatencystatP9999
-	metricRedisLatestFork                        metricRedisLatestFork
-	metricRedisMemoryFragmentationRatio          metricRedisMemoryFragmentationRatio
-	metricRedisMemoryLua                         metricRedisMemoryLua
-	metricRedisMemoryPeak                        metricRedisMemoryPeak
-	metricRedisMemoryRss                         metricRedisMemoryRss
-	metricRedisMemoryUsed                        metricRedisMemoryUsed
-	metricRedisNetInput                          metricRedisNetInput
-	metricRedisNetOutput                         metricRedisNetOutput
-	metricRedisRdbChangesSinceLastSave           metricRedisRdbChangesSinceLastSave
-	metricRedisReplicationBacklogFirstByteOffset metricRedisReplicationBacklogFirstByteOffset
-	metricRedisReplicationOffset                 metricRedisReplicationOffset
-	metricRedisSlavesConnected                   metricRedisSlavesConnected
-	metricRedisUptime                            metricRedisUptime
+	startTime                                      pdata.Timestamp
+	metricRedisAofBaseSize                         metricRedisAofBaseSize
+	metricRedisAofCurrentSize                      metricRedisAofCurrentSize
+	metricRedisAofEnabled                          metricRedisAofEnabled
+	metricRedisAofLastBgrewriteStatus              metricRedisAofLastBgrewriteStatus
+	metricRedisAofLastWriteStatus                  metricRedisAofLastWriteStatus
+	metricRedisAofRewriteInProgress                metricRedisAofRewriteInProgress
+	metricRedisClientsBlocked                      metricRedisClientsBlocked
+	metricRedisClientsConnected                    metricRedisClientsConnected
+	metricRedisClientsConnections                  metricRedisClientsConnections
+	metricRedisClientsIdleTime                     metricRedisClientsIdleTime
+	metricRedisClientsMaxInputBuffer               metricRedisClientsMaxInputBuffer
+	metricRedisClientsMaxOutputBuffer              metricRedisClientsMaxOutputBuffer
+	metricRedisClientsOutputMemory                 metricRedisClientsOutputMemory
+	metricRedisClientsQueryBuffer                  metricRedisClientsQueryBuffer
+	metricRedisCommandCalls                        metricRedisCommandCalls
+	metricRedisCommandFailedCalls                  metricRedisCommandFailedCalls
+	metricRedisCommandRejectedCalls                metricRedisCommandRejectedCalls
+	metricRedisCommandUsec                         metricRedisCommandUsec
+	metricRedisCommandUsecPerCall                  metricRedisCommandUsecPerCall
+	metricRedisCommands                            metricRedisCommands
+	metricRedisCommandsProcessed                   metricRedisCommandsProcessed
+	metricRedisConfigInfo                          metricRedisConfigInfo
+	metricRedisConfigValue                         metricRedisConfigValue
+	metricRedisConnectionsReceived                 metricRedisConnectionsReceived
+	metricRedisConnectionsRejected                 metricRedisConnectionsRejected
+	metricRedisCPUTime                             metricRedisCPUTime
+	metricRedisDbAvgTTL                            metricRedisDbAvgTTL
+	metricRedisDbExpires                           metricRedisDbExpires
+	metricRedisDbKeys                              metricRedisDbKeys
+	metricRedisDbSubexpiry                         metricRedisDbSubexpiry
+	metricRedisErrorReplies                        metricRedisErrorReplies
+	metricRedisErrors                              metricRedisErrors
+	metricRedisKeyLength                           metricRedisKeyLength
+	metricRedisKeyMemoryUsage                      metricRedisKeyMemoryUsage
+	metricRedisKeysEvicted                         metricRedisKeysEvicted
+	metricRedisKeysExpired                         metricRedisKeysExpired
+	metricRedisKeysPatternCount                    metricRedisKeysPatternCount
+	metricRedisKeysPatternMemoryUsage              metricRedisKeysPatternMemoryUsage
+	metricRedisKeyspaceHits                        metricRedisKeyspaceHits
+	metricRedisKeyspaceMisses                      metricRedisKeyspaceMisses
+	metricRedisLatencystatP100                     metricRedisLatencystatP100
+	metricRedisLatencystatP50                      metricRedisLatencystatP50
+	metricRedisLatencystatP90                      metricRedisLatencystatP90
+	metricRedisLatencystatP99                      metricRedisLatencystatP99
+	metricRedisLatencystatP999                     metricRedisLatencystatP999
+	metricRedisLatencystatP9999                    metricRedisLatencystatP9999
+	metricRedisLatestFork                          metricRedisLatestFork
+	metricRedisMaxmemory                           metricRedisMaxmemory
+	metricRedisMemoryFragmentationRatio            metricRedisMemoryFragmentationRatio
+	metricRedisMemoryLua                           metricRedisMemoryLua
+	metricRedisMemoryPeak                          metricRedisMemoryPeak
+	metricRedisMemoryRss                           metricRedisMemoryRss
+	metricRedisMemoryTotalSystem                   metricRedisMemoryTotalSystem
+	metricRedisMemoryUsed                          metricRedisMemoryUsed
+	metricRedisMemoryUtilization                   metricRedisMemoryUtilization
+	metricRedisNetInput                            metricRedisNetInput
+	metricRedisNetOutput                           metricRedisNetOutput
+	metricRedisPersistenceLoading                  metricRedisPersistenceLoading
+	metricRedisProbeLatency                        metricRedisProbeLatency
+	metricRedisProbeSuccess                        metricRedisProbeSuccess
+	metricRedisPubsubChannelSubscribers            metricRedisPubsubChannelSubscribers
+	metricRedisPubsubChannels                      metricRedisPubsubChannels
+	metricRedisPubsubPatterns                      metricRedisPubsubPatterns
+	metricRedisPubsubShardChannelSubscribers       metricRedisPubsubShardChannelSubscribers
+	metricRedisRdbBgsaveInProgress                 metricRedisRdbBgsaveInProgress
+	metricRedisRdbChangesSinceLastSave             metricRedisRdbChangesSinceLastSave
+	metricRedisRdbLastBgsaveDuration               metricRedisRdbLastBgsaveDuration
+	metricRedisRdbLastBgsaveStatus                 metricRedisRdbLastBgsaveStatus
+	metricRedisRdbLastSaveTime                     metricRedisRdbLastSaveTime
+	metricRedisReplicationBacklogFirstByteOffset   metricRedisReplicationBacklogFirstByteOffset
+	metricRedisReplicationHeartbeatLag             metricRedisReplicationHeartbeatLag
+	metricRedisReplicationMasterLinkDownSince      metricRedisReplicationMasterLinkDownSince
+	metricRedisReplicationMasterLinkLastIo         metricRedisReplicationMasterLinkLastIo
+	metricRedisReplicationMasterLinkSyncInProgress metricRedisReplicationMasterLinkSyncInProgress
+	metricRedisReplicationMasterLinkUp             metricRedisReplicationMasterLinkUp
+	metricRedisReplicationOffset                   metricRedisReplicationOffset
+	metricRedisReplicationReplicaLag               metricRedisReplicationReplicaLag
+	metricRedisReplicationReplicaOffsetDelta       metricRedisReplicationReplicaOffsetDelta
+	metricRedisReplicationReplicaState             metricRedisReplicationReplicaState
+	metricRedisScrapeError                         metricRedisScrapeError
+	metricRedisSlavesConnected                     metricRedisSlavesConnected
+	metricRedisStreamConsumerIdle                  metricRedisStreamConsumerIdle
+	metricRedisStreamConsumerPending               metricRedisStreamConsumerPending
+	metricRedisStreamGroupLag                      metricRedisStreamGroupLag
+	metricRedisStreamGroupLastDeliveredAge         metricRedisStreamGroupLastDeliveredAge
+	metricRedisStreamGroupPending                  metricRedisStreamGroupPending
+	metricRedisUp                                  metricRedisUp
+	metricRedisUptime                              metricRedisUptime
+	metricRedisWatchedKeyLength                    metricRedisWatchedKeyLength
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -2268,47 +5012,96 @@ func WithStartTime(startTime pdata.Timestamp) metricBuilderOption {
 
 func NewMetricsBuilder(settings MetricsSettings, options ...metricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		startTime:                                    pdata.NewTimestampFromTime(time.Now()),
-		metricRedisClientsBlocked:                    newMetricRedisClientsBlocked(settings.RedisClientsBlocked),
-		metricRedisClientsConnected:                  newMetricRedisClientsConnected(settings.RedisClientsConnected),
-		metricRedisClientsMaxInputBuffer:             newMetricRedisClientsMaxInputBuffer(settings.RedisClientsMaxInputBuffer),
-		metricRedisClientsMaxOutputBuffer:            newMetricRedisClientsMaxOutputBuffer(settings.RedisClientsMaxOutputBuffer),
-		metricRedisCommandCalls:                      newMetricRedisCommandCalls(settings.RedisCommandCalls),
-		metricRedisCommandFailedCalls:                newMetricRedisCommandFailedCalls(settings.RedisCommandFailedCalls),
-		metricRedisCommandRejectedCalls:              newMetricRedisCommandRejectedCalls(settings.RedisCommandRejectedCalls),
-		metricRedisCommandUsec:                       newMetricRedisCommandUsec(settings.RedisCommandUsec),
-		metricRedisCommandUsecPerCall:                newMetricRedisCommandUsecPerCall(settings.RedisCommandUsecPerCall),
-		metricRedisCommands:                          newMetricRedisCommands(settings.RedisCommands),
-		metricRedisCommandsProcessed:                 newMetricRedisCommandsProcessed(settings.RedisCommandsProcessed),
-		metricRedisConnectionsReceived:               newMetricRedisConnectionsReceived(settings.RedisConnectionsReceived),
-		metricRedisConnectionsRejected:               newMetricRedisConnectionsRejected(settings.RedisConnectionsRejected),
-		metricRedisCPUTime:                           newMetricRedisCPUTime(settings.RedisCPUTime),
-		metricRedisDbAvgTTL:                          newMetricRedisDbAvgTTL(settings.RedisDbAvgTTL),
-		metricRedisDbExpires:                         newMetricRedisDbExpires(settings.RedisDbExpires),
-		metricRedisDbKeys:                            newMetricRedisDbKeys(settings.RedisDbKeys),
-		metricRedisKeysEvicted:                       newMetricRedisKeysEvicted(settings.RedisKeysEvicted),
-		metricRedisKeysExpired:                       newMetricRedisKeysExpired(settings.RedisKeysExpired),
-		metricRedisKeyspaceHits:                      newMetricRedisKeyspaceHits(settings.RedisKeyspaceHits),
-		metricRedisKeyspaceMisses:                    newMetricRedisKeyspaceMisses(settings.RedisKeyspaceMisses),
-		metricRedisLatencystatP100:                   newMetricRedisLatencystatP100(settings.RedisLatencystatP100),
-		metricRedisLatencystatP50:                    newMetricRedisLatencystatP50(settings.RedisLatencystatP50),
-		metricRedisLatencystatP90:                    newMetricRedisLatencystatP90(settings.RedisLatencystatP90),
-		metricRedisLatencystatP99:                    newMetricRedisLatencystatP99(settings.RedisLatencystatP99),
-		metricRedisLatencystatP999:                   newMetricRedisLatencystatP999(settings.RedisLatencystatP999),
-		metricRedisLatencystatP9999:                  newMetricRedisLatencystatP9999(settings.RedisLatencystatP9999),
-		metricRedisLatestFork:                        newMetricRedisLatestFork(settings.RedisLatestFork),
-		metricRedisMemoryFragmentationRatio:          newMetricRedisMemoryFragmentationRatio(settings.RedisMemoryFragmentationRatio),
-		metricRedisMemoryLua:                         newMetricRedisMemoryLua(settings.RedisMemoryLua),
-		metricRedisMemoryPeak:                        newMetricRedisMemoryPeak(settings.RedisMemoryPeak),
-		metricRedisMemoryRss:                         newMetricRedisMemoryRss(settings.RedisMemoryRss),
-		metricRedisMemoryUsed:                        newMetricRedisMemoryUsed(settings.RedisMemoryUsed),
-		metricRedisNetInput:                          newMetricRedisNetInput(settings.RedisNetInput),
-		metricRedisNetOutput:                         newMetricRedisNetOutput(settings.RedisNetOutput),
-		metricRedisRdbChangesSinceLastSave:           newMetricRedisRdbChangesSinceLastSave(settings.RedisRdbChangesSinceLastSave),
-		metricRedisReplicationBacklogFirstByteOffset: newMetricRedisReplicationBacklogFirstByteOffset(settings.RedisReplicationBacklogFirstByteOffset),
-		metricRedisReplicationOffset:                 newMetricRedisReplicationOffset(settings.RedisReplicationOffset),
-		metricRedisSlavesConnected:                   newMetricRedisSlavesConnected(settings.RedisSlavesConnected),
-		metricRedisUptime:                            newMetricRedisUptime(settings.RedisUptime),
+		startTime:                                      pdata.NewTimestampFromTime(time.Now()),
+		metricRedisAofBaseSize:                         newMetricRedisAofBaseSize(settings.RedisAofBaseSize),
+		metricRedisAofCurrentSize:                      newMetricRedisAofCurrentSize(settings.RedisAofCurrentSize),
+		metricRedisAofEnabled:                          newMetricRedisAofEnabled(settings.RedisAofEnabled),
+		metricRedisAofLastBgrewriteStatus:              newMetricRedisAofLastBgrewriteStatus(settings.RedisAofLastBgrewriteStatus),
+		metricRedisAofLastWriteStatus:                  newMetricRedisAofLastWriteStatus(settings.RedisAofLastWriteStatus),
+		metricRedisAofRewriteInProgress:                newMetricRedisAofRewriteInProgress(settings.RedisAofRewriteInProgress),
+		metricRedisClientsBlocked:                      newMetricRedisClientsBlocked(settings.RedisClientsBlocked),
+		metricRedisClientsConnected:                    newMetricRedisClientsConnected(settings.RedisClientsConnected),
+		metricRedisClientsConnections:                  newMetricRedisClientsConnections(settings.RedisClientsConnections),
+		metricRedisClientsIdleTime:                     newMetricRedisClientsIdleTime(settings.RedisClientsIdleTime),
+		metricRedisClientsMaxInputBuffer:               newMetricRedisClientsMaxInputBuffer(settings.RedisClientsMaxInputBuffer),
+		metricRedisClientsMaxOutputBuffer:              newMetricRedisClientsMaxOutputBuffer(settings.RedisClientsMaxOutputBuffer),
+		metricRedisClientsOutputMemory:                 newMetricRedisClientsOutputMemory(settings.RedisClientsOutputMemory),
+		metricRedisClientsQueryBuffer:                  newMetricRedisClientsQueryBuffer(settings.RedisClientsQueryBuffer),
+		metricRedisCommandCalls:                        newMetricRedisCommandCalls(settings.RedisCommandCalls),
+		metricRedisCommandFailedCalls:                  newMetricRedisCommandFailedCalls(settings.RedisCommandFailedCalls),
+		metricRedisCommandRejectedCalls:                newMetricRedisCommandRejectedCalls(settings.RedisCommandRejectedCalls),
+		metricRedisCommandUsec:                         newMetricRedisCommandUsec(settings.RedisCommandUsec),
+		metricRedisCommandUsecPerCall:                  newMetricRedisCommandUsecPerCall(settings.RedisCommandUsecPerCall),
+		metricRedisCommands:                            newMetricRedisCommands(settings.RedisCommands),
+		metricRedisCommandsProcessed:                   newMetricRedisCommandsProcessed(settings.RedisCommandsProcessed),
+		metricRedisConfigInfo:                          newMetricRedisConfigInfo(settings.RedisConfigInfo),
+		metricRedisConfigValue:                         newMetricRedisConfigValue(settings.RedisConfigValue),
+		metricRedisConnectionsReceived:                 newMetricRedisConnectionsReceived(settings.RedisConnectionsReceived),
+		metricRedisConnectionsRejected:                 newMetricRedisConnectionsRejected(settings.RedisConnectionsRejected),
+		metricRedisCPUTime:                             newMetricRedisCPUTime(settings.RedisCPUTime),
+		metricRedisDbAvgTTL:                            newMetricRedisDbAvgTTL(settings.RedisDbAvgTTL),
+		metricRedisDbExpires:                           newMetricRedisDbExpires(settings.RedisDbExpires),
+		metricRedisDbKeys:                              newMetricRedisDbKeys(settings.RedisDbKeys),
+		metricRedisDbSubexpiry:                         newMetricRedisDbSubexpiry(settings.RedisDbSubexpiry),
+		metricRedisErrorReplies:                        newMetricRedisErrorReplies(settings.RedisErrorReplies),
+		metricRedisErrors:                              newMetricRedisErrors(settings.RedisErrors),
+		metricRedisKeyLength:                           newMetricRedisKeyLength(settings.RedisKeyLength),
+		metricRedisKeyMemoryUsage:                      newMetricRedisKeyMemoryUsage(settings.RedisKeyMemoryUsage),
+		metricRedisKeysEvicted:                         newMetricRedisKeysEvicted(settings.RedisKeysEvicted),
+		metricRedisKeysExpired:                         newMetricRedisKeysExpired(settings.RedisKeysExpired),
+		metricRedisKeysPatternCount:                    newMetricRedisKeysPatternCount(settings.RedisKeysPatternCount),
+		metricRedisKeysPatternMemoryUsage:              newMetricRedisKeysPatternMemoryUsage(settings.RedisKeysPatternMemoryUsage),
+		metricRedisKeyspaceHits:                        newMetricRedisKeyspaceHits(settings.RedisKeyspaceHits),
+		metricRedisKeyspaceMisses:                      newMetricRedisKeyspaceMisses(settings.RedisKeyspaceMisses),
+		metricRedisLatencystatP100:                     newMetricRedisLatencystatP100(settings.RedisLatencystatP100),
+		metricRedisLatencystatP50:                      newMetricRedisLatencystatP50(settings.RedisLatencystatP50),
+		metricRedisLatencystatP90:                      newMetricRedisLatencystatP90(settings.RedisLatencystatP90),
+		metricRedisLatencystatP99:                      newMetricRedisLatencystatP99(settings.RedisLatencystatP99),
+		metricRedisLatencystatP999:                     newMetricRedisLatencystatP999(settings.RedisLatencystatP999),
+		metricRedisLatencystatP9999:                    newMetricRedisLatencystatP9999(settings.RedisLatencystatP9999),
+		metricRedisLatestFork:                          newMetricRedisLatestFork(settings.RedisLatestFork),
+		metricRedisMaxmemory:                           newMetricRedisMaxmemory(settings.RedisMaxmemory),
+		metricRedisMemoryFragmentationRatio:            newMetricRedisMemoryFragmentationRatio(settings.RedisMemoryFragmentationRatio),
+		metricRedisMemoryLua:                           newMetricRedisMemoryLua(settings.RedisMemoryLua),
+		metricRedisMemoryPeak:                          newMetricRedisMemoryPeak(settings.RedisMemoryPeak),
+		metricRedisMemoryRss:                           newMetricRedisMemoryRss(settings.RedisMemoryRss),
+		metricRedisMemoryTotalSystem:                   newMetricRedisMemoryTotalSystem(settings.RedisMemoryTotalSystem),
+		metricRedisMemoryUsed:                          newMetricRedisMemoryUsed(settings.RedisMemoryUsed),
+		metricRedisMemoryUtilization:                   newMetricRedisMemoryUtilization(settings.RedisMemoryUtilization),
+		metricRedisNetInput:                            newMetricRedisNetInput(settings.RedisNetInput),
+		metricRedisNetOutput:                           newMetricRedisNetOutput(settings.RedisNetOutput),
+		metricRedisPersistenceLoading:                  newMetricRedisPersistenceLoading(settings.RedisPersistenceLoading),
+		metricRedisProbeLatency:                        newMetricRedisProbeLatency(settings.RedisProbeLatency),
+		metricRedisProbeSuccess:                        newMetricRedisProbeSuccess(settings.RedisProbeSuccess),
+		metricRedisPubsubChannelSubscribers:            newMetricRedisPubsubChannelSubscribers(settings.RedisPubsubChannelSubscribers),
+		metricRedisPubsubChannels:                      newMetricRedisPubsubChannels(settings.RedisPubsubChannels),
+		metricRedisPubsubPatterns:                      newMetricRedisPubsubPatterns(settings.RedisPubsubPatterns),
+		metricRedisPubsubShardChannelSubscribers:       newMetricRedisPubsubShardChannelSubscribers(settings.RedisPubsubShardChannelSubscribers),
+		metricRedisRdbBgsaveInProgress:                 newMetricRedisRdbBgsaveInProgress(settings.RedisRdbBgsaveInProgress),
+		metricRedisRdbChangesSinceLastSave:             newMetricRedisRdbChangesSinceLastSave(settings.RedisRdbChangesSinceLastSave),
+		metricRedisRdbLastBgsaveDuration:               newMetricRedisRdbLastBgsaveDuration(settings.RedisRdbLastBgsaveDuration),
+		metricRedisRdbLastBgsaveStatus:                 newMetricRedisRdbLastBgsaveStatus(settings.RedisRdbLastBgsaveStatus),
+		metricRedisRdbLastSaveTime:                     newMetricRedisRdbLastSaveTime(settings.RedisRdbLastSaveTime),
+		metricRedisReplicationBacklogFirstByteOffset:   newMetricRedisReplicationBacklogFirstByteOffset(settings.RedisReplicationBacklogFirstByteOffset),
+		metricRedisReplicationHeartbeatLag:             newMetricRedisReplicationHeartbeatLag(settings.RedisReplicationHeartbeatLag),
+		metricRedisReplicationMasterLinkDownSince:      newMetricRedisReplicationMasterLinkDownSince(settings.RedisReplicationMasterLinkDownSince),
+		metricRedisReplicationMasterLinkLastIo:         newMetricRedisReplicationMasterLinkLastIo(settings.RedisReplicationMasterLinkLastIo),
+		metricRedisReplicationMasterLinkSyncInProgress: newMetricRedisReplicationMasterLinkSyncInProgress(settings.RedisReplicationMasterLinkSyncInProgress),
+		metricRedisReplicationMasterLinkUp:             newMetricRedisReplicationMasterLinkUp(settings.RedisReplicationMasterLinkUp),
+		metricRedisReplicationOffset:                   newMetricRedisReplicationOffset(settings.RedisReplicationOffset),
+		metricRedisReplicationReplicaLag:               newMetricRedisReplicationReplicaLag(settings.RedisReplicationReplicaLag),
+		metricRedisReplicationReplicaOffsetDelta:       newMetricRedisReplicationReplicaOffsetDelta(settings.RedisReplicationReplicaOffsetDelta),
+		metricRedisReplicationReplicaState:             newMetricRedisReplicationReplicaState(settings.RedisReplicationReplicaState),
+		metricRedisScrapeError:                         newMetricRedisScrapeError(settings.RedisScrapeError),
+		metricRedisSlavesConnected:                     newMetricRedisSlavesConnected(settings.RedisSlavesConnected),
+		metricRedisStreamConsumerIdle:                  newMetricRedisStreamConsumerIdle(settings.RedisStreamConsumerIdle),
+		metricRedisStreamConsumerPending:               newMetricRedisStreamConsumerPending(settings.RedisStreamConsumerPending),
+		metricRedisStreamGroupLag:                      newMetricRedisStreamGroupLag(settings.RedisStreamGroupLag),
+		metricRedisStreamGroupLastDeliveredAge:         newMetricRedisStreamGroupLastDeliveredAge(settings.RedisStreamGroupLastDeliveredAge),
+		metricRedisStreamGroupPending:                  newMetricRedisStreamGroupPending(settings.RedisStreamGroupPending),
+		metricRedisUp:                                  newMetricRedisUp(settings.RedisUp),
+		metricRedisUptime:                              newMetricRedisUptime(settings.RedisUptime),
+		metricRedisWatchedKeyLength:                    newMetricRedisWatchedKeyLength(settings.RedisWatchedKeyLength),
 	}
 	for _, op := range options {
 		op(mb)
@@ -2320,10 +5113,20 @@ func NewMetricsBuilder(settings MetricsSettings, options ...metricBuilderOption)
 // another set of data points. This function will be doing all transformations required to produce metric representation
 // defined in metadata and user settings, e.g. delta/cumulative translation.
 func (mb *MetricsBuilder) Emit(metrics pdata.MetricSlice) {
+	mb.metricRedisAofBaseSize.emit(metrics)
+	mb.metricRedisAofCurrentSize.emit(metrics)
+	mb.metricRedisAofEnabled.emit(metrics)
+	mb.metricRedisAofLastBgrewriteStatus.emit(metrics)
+	mb.metricRedisAofLastWriteStatus.emit(metrics)
+	mb.metricRedisAofRewriteInProgress.emit(metrics)
 	mb.metricRedisClientsBlocked.emit(metrics)
 	mb.metricRedisClientsConnected.emit(metrics)
+	mb.metricRedisClientsConnections.emit(metrics)
+	mb.metricRedisClientsIdleTime.emit(metrics)
 	mb.metricRedisClientsMaxInputBuffer.emit(metrics)
 	mb.metricRedisClientsMaxOutputBuffer.emit(metrics)
+	mb.metricRedisClientsOutputMemory.emit(metrics)
+	mb.metricRedisClientsQueryBuffer.emit(metrics)
 	mb.metricRedisCommandCalls.emit(metrics)
 	mb.metricRedisCommandFailedCalls.emit(metrics)
 	mb.metricRedisCommandRejectedCalls.emit(metrics)
@@ -2331,14 +5134,23 @@ func (mb *MetricsBuilder) Emit(metrics pdata.MetricSlice) {
 	mb.metricRedisCommandUsecPerCall.emit(metrics)
 	mb.metricRedisCommands.emit(metrics)
 	mb.metricRedisCommandsProcessed.emit(metrics)
+	mb.metricRedisConfigInfo.emit(metrics)
+	mb.metricRedisConfigValue.emit(metrics)
 	mb.metricRedisConnectionsReceived.emit(metrics)
 	mb.metricRedisConnectionsRejected.emit(metrics)
 	mb.metricRedisCPUTime.emit(metrics)
 	mb.metricRedisDbAvgTTL.emit(metrics)
 	mb.metricRedisDbExpires.emit(metrics)
 	mb.metricRedisDbKeys.emit(metrics)
+	mb.metricRedisDbSubexpiry.emit(metrics)
+	mb.metricRedisErrorReplies.emit(metrics)
+	mb.metricRedisErrors.emit(metrics)
+	mb.metricRedisKeyLength.emit(metrics)
+	mb.metricRedisKeyMemoryUsage.emit(metrics)
 	mb.metricRedisKeysEvicted.emit(metrics)
 	mb.metricRedisKeysExpired.emit(metrics)
+	mb.metricRedisKeysPatternCount.emit(metrics)
+	mb.metricRedisKeysPatternMemoryUsage.emit(metrics)
 	mb.metricRedisKeyspaceHits.emit(metrics)
 	mb.metricRedisKeyspaceMisses.emit(metrics)
 	mb.metricRedisLatencystatP100.emit(metrics)
@@ -2348,18 +5160,78 @@ func (mb *MetricsBuilder) Emit(metrics pdata.MetricSlice) {
 	mb.metricRedisLatencystatP999.emit(metrics)
 	mb.metricRedisLatencystatP9999.emit(metrics)
 	mb.metricRedisLatestFork.emit(metrics)
+	mb.metricRedisMaxmemory.emit(metrics)
 	mb.metricRedisMemoryFragmentationRatio.emit(metrics)
 	mb.metricRedisMemoryLua.emit(metrics)
 	mb.metricRedisMemoryPeak.emit(metrics)
 	mb.metricRedisMemoryRss.emit(metrics)
+	mb.metricRedisMemoryTotalSystem.emit(metrics)
 	mb.metricRedisMemoryUsed.emit(metrics)
+	mb.metricRedisMemoryUtilization.emit(metrics)
 	mb.metricRedisNetInput.emit(metrics)
 	mb.metricRedisNetOutput.emit(metrics)
+	mb.metricRedisPersistenceLoading.emit(metrics)
+	mb.metricRedisProbeLatency.emit(metrics)
+	mb.metricRedisProbeSuccess.emit(metrics)
+	mb.metricRedisPubsubChannelSubscribers.emit(metrics)
+	mb.metricRedisPubsubChannels.emit(metrics)
+	mb.metricRedisPubsubPatterns.emit(metrics)
+	mb.metricRedisPubsubShardChannelSubscribers.emit(metrics)
+	mb.metricRedisRdbBgsaveInProgress.emit(metrics)
 	mb.metricRedisRdbChangesSinceLastSave.emit(metrics)
+	mb.metricRedisRdbLastBgsaveDuration.emit(metrics)
+	mb.metricRedisRdbLastBgsaveStatus.emit(metrics)
+	mb.metricRedisRdbLastSaveTime.emit(metrics)
 	mb.metricRedisReplicationBacklogFirstByteOffset.emit(metrics)
+	mb.metricRedisReplicationHeartbeatLag.emit(metrics)
+	mb.metricRedisReplicationMasterLinkDownSince.emit(metrics)
+	mb.metricRedisReplicationMasterLinkLastIo.emit(metrics)
+	mb.metricRedisReplicationMasterLinkSyncInProgress.emit(metrics)
+	mb.metricRedisReplicationMasterLinkUp.emit(metrics)
 	mb.metricRedisReplicationOffset.emit(metrics)
+	mb.metricRedisReplicationReplicaLag.emit(metrics)
+	mb.metricRedisReplicationReplicaOffsetDelta.emit(metrics)
+	mb.metricRedisReplicationReplicaState.emit(metrics)
+	mb.metricRedisScrapeError.emit(metrics)
 	mb.metricRedisSlavesConnected.emit(metrics)
+	mb.metricRedisStreamConsumerIdle.emit(metrics)
+	mb.metricRedisStreamConsumerPending.emit(metrics)
+	mb.metricRedisStreamGroupLag.emit(metrics)
+	mb.metricRedisStreamGroupLastDeliveredAge.emit(metrics)
+	mb.metricRedisStreamGroupPending.emit(metrics)
+	mb.metricRedisUp.emit(metrics)
 	mb.metricRedisUptime.emit(metrics)
+	mb.metricRedisWatchedKeyLength.emit(metrics)
+}
+
+// RecordRedisAofBaseSizeDataPoint adds a data point to redis.aof.base_size metric.
+func (mb *MetricsBuilder) RecordRedisAofBaseSizeDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisAofBaseSize.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisAofCurrentSizeDataPoint adds a data point to redis.aof.current_size metric.
+func (mb *MetricsBuilder) RecordRedisAofCurrentSizeDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisAofCurrentSize.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisAofEnabledDataPoint adds a data point to redis.aof.enabled metric.
+func (mb *MetricsBuilder) RecordRedisAofEnabledDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisAofEnabled.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisAofLastBgrewriteStatusDataPoint adds a data point to redis.aof.last_bgrewrite.status metric.
+func (mb *MetricsBuilder) RecordRedisAofLastBgrewriteStatusDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisAofLastBgrewriteStatus.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisAofLastWriteStatusDataPoint adds a data point to redis.aof.last_write.status metric.
+func (mb *MetricsBuilder) RecordRedisAofLastWriteStatusDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisAofLastWriteStatus.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisAofRewriteInProgressDataPoint adds a data point to redis.aof.rewrite.in_progress metric.
+func (mb *MetricsBuilder) RecordRedisAofRewriteInProgressDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisAofRewriteInProgress.recordDataPoint(mb.startTime, ts, val)
 }
 
 // RecordRedisClientsBlockedDataPoint adds a data point to redis.clients.blocked metric.
@@ -2372,6 +5244,16 @@ func (mb *MetricsBuilder) RecordRedisClientsConnectedDataPoint(ts pdata.Timestam
 	mb.metricRedisClientsConnected.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisClientsConnectionsDataPoint adds a data point to redis.clients.connections metric.
+func (mb *MetricsBuilder) RecordRedisClientsConnectionsDataPoint(ts pdata.Timestamp, val int64, clientNameAttributeValue string, clientLibNameAttributeValue string, clientUserAttributeValue string, clientDbAttributeValue string, clientFlagsAttributeValue string) {
+	mb.metricRedisClientsConnections.recordDataPoint(mb.startTime, ts, val, clientNameAttributeValue, clientLibNameAttributeValue, clientUserAttributeValue, clientDbAttributeValue, clientFlagsAttributeValue)
+}
+
+// RecordRedisClientsIdleTimeDataPoint adds a data point to redis.clients.idle_time metric.
+func (mb *MetricsBuilder) RecordRedisClientsIdleTimeDataPoint(ts pdata.Timestamp, val int64, clientNameAttributeValue string, clientLibNameAttributeValue string, clientUserAttributeValue string, clientDbAttributeValue string, clientFlagsAttributeValue string) {
+	mb.metricRedisClientsIdleTime.recordDataPoint(mb.startTime, ts, val, clientNameAttributeValue, clientLibNameAttributeValue, clientUserAttributeValue, clientDbAttributeValue, clientFlagsAttributeValue)
+}
+
 // RecordRedisClientsMaxInputBufferDataPoint adds a data point to redis.clients.max_input_buffer metric.
 func (mb *MetricsBuilder) RecordRedisClientsMaxInputBufferDataPoint(ts pdata.Timestamp, val int64) {
 	mb.metricRedisClientsMaxInputBuffer.recordDataPoint(mb.startTime, ts, val)
@@ -2382,6 +5264,16 @@ func (mb *MetricsBuilder) RecordRedisClientsMaxOutputBufferDataPoint(ts pdata.Ti
 	mb.metricRedisClientsMaxOutputBuffer.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisClientsOutputMemoryDataPoint adds a data point to redis.clients.output_memory metric.
+func (mb *MetricsBuilder) RecordRedisClientsOutputMemoryDataPoint(ts pdata.Timestamp, val int64, clientNameAttributeValue string, clientLibNameAttributeValue string, clientUserAttributeValue string, clientDbAttributeValue string, clientFlagsAttributeValue string) {
+	mb.metricRedisClientsOutputMemory.recordDataPoint(mb.startTime, ts, val, clientNameAttributeValue, clientLibNameAttributeValue, clientUserAttributeValue, clientDbAttributeValue, clientFlagsAttributeValue)
+}
+
+// RecordRedisClientsQueryBufferDataPoint adds a data point to redis.clients.query_buffer metric.
+func (mb *MetricsBuilder) RecordRedisClientsQueryBufferDataPoint(ts pdata.Timestamp, val int64, clientNameAttributeValue string, clientLibNameAttributeValue string, clientUserAttributeValue string, clientDbAttributeValue string, clientFlagsAttributeValue string) {
+	mb.metricRedisClientsQueryBuffer.recordDataPoint(mb.startTime, ts, val, clientNameAttributeValue, clientLibNameAttributeValue, clientUserAttributeValue, clientDbAttributeValue, clientFlagsAttributeValue)
+}
+
 // RecordRedisCommandCallsDataPoint adds a data point to redis.command.calls metric.
 func (mb *MetricsBuilder) RecordRedisCommandCallsDataPoint(ts pdata.Timestamp, val int64, commandAttributeValue string) {
 	mb.metricRedisCommandCalls.recordDataPoint(mb.startTime, ts, val, commandAttributeValue)
@@ -2417,6 +5309,16 @@ func (mb *MetricsBuilder) RecordRedisCommandsProcessedDataPoint(ts pdata.Timesta
 	mb.metricRedisCommandsProcessed.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisConfigInfoDataPoint adds a data point to redis.config.info metric.
+func (mb *MetricsBuilder) RecordRedisConfigInfoDataPoint(ts pdata.Timestamp, val int64, parameterAttributeValue string, parameterValueAttributeValue string) {
+	mb.metricRedisConfigInfo.recordDataPoint(mb.startTime, ts, val, parameterAttributeValue, parameterValueAttributeValue)
+}
+
+// RecordRedisConfigValueDataPoint adds a data point to redis.config.value metric.
+func (mb *MetricsBuilder) RecordRedisConfigValueDataPoint(ts pdata.Timestamp, val float64, parameterAttributeValue string) {
+	mb.metricRedisConfigValue.recordDataPoint(mb.startTime, ts, val, parameterAttributeValue)
+}
+
 // RecordRedisConnectionsReceivedDataPoint adds a data point to redis.connections.received metric.
 func (mb *MetricsBuilder) RecordRedisConnectionsReceivedDataPoint(ts pdata.Timestamp, val int64) {
 	mb.metricRedisConnectionsReceived.recordDataPoint(mb.startTime, ts, val)
@@ -2447,6 +5349,31 @@ func (mb *MetricsBuilder) RecordRedisDbKeysDataPoint(ts pdata.Timestamp, val int
 	mb.metricRedisDbKeys.recordDataPoint(mb.startTime, ts, val, dbAttributeValue)
 }
 
+// RecordRedisDbSubexpiryDataPoint adds a data point to redis.db.subexpiry metric.
+func (mb *MetricsBuilder) RecordRedisDbSubexpiryDataPoint(ts pdata.Timestamp, val int64, dbAttributeValue string) {
+	mb.metricRedisDbSubexpiry.recordDataPoint(mb.startTime, ts, val, dbAttributeValue)
+}
+
+// RecordRedisErrorRepliesDataPoint adds a data point to redis.error_replies metric.
+func (mb *MetricsBuilder) RecordRedisErrorRepliesDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisErrorReplies.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisErrorsDataPoint adds a data point to redis.errors metric.
+func (mb *MetricsBuilder) RecordRedisErrorsDataPoint(ts pdata.Timestamp, val int64, errorPrefixAttributeValue string) {
+	mb.metricRedisErrors.recordDataPoint(mb.startTime, ts, val, errorPrefixAttributeValue)
+}
+
+// RecordRedisKeyLengthDataPoint adds a data point to redis.key.length metric.
+func (mb *MetricsBuilder) RecordRedisKeyLengthDataPoint(ts pdata.Timestamp, val int64, dbAttributeValue string, keyAttributeValue string, keyTypeAttributeValue string) {
+	mb.metricRedisKeyLength.recordDataPoint(mb.startTime, ts, val, dbAttributeValue, keyAttributeValue, keyTypeAttributeValue)
+}
+
+// RecordRedisKeyMemoryUsageDataPoint adds a data point to redis.key.memory_usage metric.
+func (mb *MetricsBuilder) RecordRedisKeyMemoryUsageDataPoint(ts pdata.Timestamp, val int64, dbAttributeValue string, keyAttributeValue string, keyTypeAttributeValue string) {
+	mb.metricRedisKeyMemoryUsage.recordDataPoint(mb.startTime, ts, val, dbAttributeValue, keyAttributeValue, keyTypeAttributeValue)
+}
+
 // RecordRedisKeysEvictedDataPoint adds a data point to redis.keys.evicted metric.
 func (mb *MetricsBuilder) RecordRedisKeysEvictedDataPoint(ts pdata.Timestamp, val int64) {
 	mb.metricRedisKeysEvicted.recordDataPoint(mb.startTime, ts, val)
@@ -2457,6 +5384,16 @@ func (mb *MetricsBuilder) RecordRedisKeysExpiredDataPoint(ts pdata.Timestamp, va
 	mb.metricRedisKeysExpired.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisKeysPatternCountDataPoint adds a data point to redis.keys.pattern.count metric.
+func (mb *MetricsBuilder) RecordRedisKeysPatternCountDataPoint(ts pdata.Timestamp, val int64, dbAttributeValue string, patternAttributeValue string) {
+	mb.metricRedisKeysPatternCount.recordDataPoint(mb.startTime, ts, val, dbAttributeValue, patternAttributeValue)
+}
+
+// RecordRedisKeysPatternMemoryUsageDataPoint adds a data point to redis.keys.pattern.memory_usage metric.
+func (mb *MetricsBuilder) RecordRedisKeysPatternMemoryUsageDataPoint(ts pdata.Timestamp, val int64, dbAttributeValue string, patternAttributeValue string) {
+	mb.metricRedisKeysPatternMemoryUsage.recordDataPoint(mb.startTime, ts, val, dbAttributeValue, patternAttributeValue)
+}
+
 // RecordRedisKeyspaceHitsDataPoint adds a data point to redis.keyspace.hits metric.
 func (mb *MetricsBuilder) RecordRedisKeyspaceHitsDataPoint(ts pdata.Timestamp, val int64) {
 	mb.metricRedisKeyspaceHits.recordDataPoint(mb.startTime, ts, val)
@@ -2502,6 +5439,11 @@ func (mb *MetricsBuilder) RecordRedisLatestForkDataPoint(ts pdata.Timestamp, val
 	mb.metricRedisLatestFork.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisMaxmemoryDataPoint adds a data point to redis.maxmemory metric.
+func (mb *MetricsBuilder) RecordRedisMaxmemoryDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisMaxmemory.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRedisMemoryFragmentationRatioDataPoint adds a data point to redis.memory.fragmentation_ratio metric.
 func (mb *MetricsBuilder) RecordRedisMemoryFragmentationRatioDataPoint(ts pdata.Timestamp, val float64) {
 	mb.metricRedisMemoryFragmentationRatio.recordDataPoint(mb.startTime, ts, val)
@@ -2522,11 +5464,21 @@ func (mb *MetricsBuilder) RecordRedisMemoryRssDataPoint(ts pdata.Timestamp, val
 	mb.metricRedisMemoryRss.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisMemoryTotalSystemDataPoint adds a data point to redis.memory.total_system metric.
+func (mb *MetricsBuilder) RecordRedisMemoryTotalSystemDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisMemoryTotalSystem.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRedisMemoryUsedDataPoint adds a data point to redis.memory.used metric.
 func (mb *MetricsBuilder) RecordRedisMemoryUsedDataPoint(ts pdata.Timestamp, val int64) {
 	mb.metricRedisMemoryUsed.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisMemoryUtilizationDataPoint adds a data point to redis.memory.utilization metric.
+func (mb *MetricsBuilder) RecordRedisMemoryUtilizationDataPoint(ts pdata.Timestamp, val float64) {
+	mb.metricRedisMemoryUtilization.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRedisNetInputDataPoint adds a data point to redis.net.input metric.
 func (mb *MetricsBuilder) RecordRedisNetInputDataPoint(ts pdata.Timestamp, val int64) {
 	mb.metricRedisNetInput.recordDataPoint(mb.startTime, ts, val)
@@ -2537,31 +5489,166 @@ func (mb *MetricsBuilder) RecordRedisNetOutputDataPoint(ts pdata.Timestamp, val
 	mb.metricRedisNetOutput.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisPersistenceLoadingDataPoint adds a data point to redis.persistence.loading metric.
+func (mb *MetricsBuilder) RecordRedisPersistenceLoadingDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisPersistenceLoading.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisProbeLatencyDataPoint adds a data point to redis.probe.latency metric.
+func (mb *MetricsBuilder) RecordRedisProbeLatencyDataPoint(ts pdata.Timestamp, val int64, probeAttributeValue string) {
+	mb.metricRedisProbeLatency.recordDataPoint(mb.startTime, ts, val, probeAttributeValue)
+}
+
+// RecordRedisProbeSuccessDataPoint adds a data point to redis.probe.success metric.
+func (mb *MetricsBuilder) RecordRedisProbeSuccessDataPoint(ts pdata.Timestamp, val int64, probeAttributeValue string) {
+	mb.metricRedisProbeSuccess.recordDataPoint(mb.startTime, ts, val, probeAttributeValue)
+}
+
+// RecordRedisPubsubChannelSubscribersDataPoint adds a data point to redis.pubsub.channel.subscribers metric.
+func (mb *MetricsBuilder) RecordRedisPubsubChannelSubscribersDataPoint(ts pdata.Timestamp, val int64, channelAttributeValue string) {
+	mb.metricRedisPubsubChannelSubscribers.recordDataPoint(mb.startTime, ts, val, channelAttributeValue)
+}
+
+// RecordRedisPubsubChannelsDataPoint adds a data point to redis.pubsub.channels metric.
+func (mb *MetricsBuilder) RecordRedisPubsubChannelsDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisPubsubChannels.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisPubsubPatternsDataPoint adds a data point to redis.pubsub.patterns metric.
+func (mb *MetricsBuilder) RecordRedisPubsubPatternsDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisPubsubPatterns.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisPubsubShardChannelSubscribersDataPoint adds a data point to redis.pubsub.shard_channel.subscribers metric.
+func (mb *MetricsBuilder) RecordRedisPubsubShardChannelSubscribersDataPoint(ts pdata.Timestamp, val int64, channelAttributeValue string) {
+	mb.metricRedisPubsubShardChannelSubscribers.recordDataPoint(mb.startTime, ts, val, channelAttributeValue)
+}
+
+// RecordRedisRdbBgsaveInProgressDataPoint adds a data point to redis.rdb.bgsave.in_progress metric.
+func (mb *MetricsBuilder) RecordRedisRdbBgsaveInProgressDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisRdbBgsaveInProgress.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRedisRdbChangesSinceLastSaveDataPoint adds a data point to redis.rdb.changes_since_last_save metric.
 func (mb *MetricsBuilder) RecordRedisRdbChangesSinceLastSaveDataPoint(ts pdata.Timestamp, val int64) {
 	mb.metricRedisRdbChangesSinceLastSave.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisRdbLastBgsaveDurationDataPoint adds a data point to redis.rdb.last_bgsave.duration metric.
+func (mb *MetricsBuilder) RecordRedisRdbLastBgsaveDurationDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisRdbLastBgsaveDuration.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisRdbLastBgsaveStatusDataPoint adds a data point to redis.rdb.last_bgsave.status metric.
+func (mb *MetricsBuilder) RecordRedisRdbLastBgsaveStatusDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisRdbLastBgsaveStatus.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisRdbLastSaveTimeDataPoint adds a data point to redis.rdb.last_save.time metric.
+func (mb *MetricsBuilder) RecordRedisRdbLastSaveTimeDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisRdbLastSaveTime.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRedisReplicationBacklogFirstByteOffsetDataPoint adds a data point to redis.replication.backlog_first_byte_offset metric.
 func (mb *MetricsBuilder) RecordRedisReplicationBacklogFirstByteOffsetDataPoint(ts pdata.Timestamp, val int64) {
 	mb.metricRedisReplicationBacklogFirstByteOffset.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisReplicationHeartbeatLagDataPoint adds a data point to redis.replication.heartbeat_lag metric.
+func (mb *MetricsBuilder) RecordRedisReplicationHeartbeatLagDataPoint(ts pdata.Timestamp, val float64, replicaAttributeValue string) {
+	mb.metricRedisReplicationHeartbeatLag.recordDataPoint(mb.startTime, ts, val, replicaAttributeValue)
+}
+
+// RecordRedisReplicationMasterLinkDownSinceDataPoint adds a data point to redis.replication.master_link.down_since metric.
+func (mb *MetricsBuilder) RecordRedisReplicationMasterLinkDownSinceDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisReplicationMasterLinkDownSince.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisReplicationMasterLinkLastIoDataPoint adds a data point to redis.replication.master_link.last_io metric.
+func (mb *MetricsBuilder) RecordRedisReplicationMasterLinkLastIoDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisReplicationMasterLinkLastIo.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisReplicationMasterLinkSyncInProgressDataPoint adds a data point to redis.replication.master_link.sync_in_progress metric.
+func (mb *MetricsBuilder) RecordRedisReplicationMasterLinkSyncInProgressDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisReplicationMasterLinkSyncInProgress.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordRedisReplicationMasterLinkUpDataPoint adds a data point to redis.replication.master_link.up metric.
+func (mb *MetricsBuilder) RecordRedisReplicationMasterLinkUpDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisReplicationMasterLinkUp.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRedisReplicationOffsetDataPoint adds a data point to redis.replication.offset metric.
 func (mb *MetricsBuilder) RecordRedisReplicationOffsetDataPoint(ts pdata.Timestamp, val int64) {
 	mb.metricRedisReplicationOffset.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisReplicationReplicaLagDataPoint adds a data point to redis.replication.replica.lag metric.
+func (mb *MetricsBuilder) RecordRedisReplicationReplicaLagDataPoint(ts pdata.Timestamp, val int64, replicaAttributeValue string) {
+	mb.metricRedisReplicationReplicaLag.recordDataPoint(mb.startTime, ts, val, replicaAttributeValue)
+}
+
+// RecordRedisReplicationReplicaOffsetDeltaDataPoint adds a data point to redis.replication.replica.offset_delta metric.
+func (mb *MetricsBuilder) RecordRedisReplicationReplicaOffsetDeltaDataPoint(ts pdata.Timestamp, val int64, replicaAttributeValue string) {
+	mb.metricRedisReplicationReplicaOffsetDelta.recordDataPoint(mb.startTime, ts, val, replicaAttributeValue)
+}
+
+// RecordRedisReplicationReplicaStateDataPoint adds a data point to redis.replication.replica.state metric.
+func (mb *MetricsBuilder) RecordRedisReplicationReplicaStateDataPoint(ts pdata.Timestamp, val int64, replicaAttributeValue string, replicaStateAttributeValue string) {
+	mb.metricRedisReplicationReplicaState.recordDataPoint(mb.startTime, ts, val, replicaAttributeValue, replicaStateAttributeValue)
+}
+
+// RecordRedisScrapeErrorDataPoint adds a data point to redis.scrape.error metric.
+func (mb *MetricsBuilder) RecordRedisScrapeErrorDataPoint(ts pdata.Timestamp, val int64, categoryAttributeValue string) {
+	mb.metricRedisScrapeError.recordDataPoint(mb.startTime, ts, val, categoryAttributeValue)
+}
+
 // RecordRedisSlavesConnectedDataPoint adds a data point to redis.slaves.connected metric.
 func (mb *MetricsBuilder) RecordRedisSlavesConnectedDataPoint(ts pdata.Timestamp, val int64) {
 	mb.metricRedisSlavesConnected.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisStreamConsumerIdleDataPoint adds a data point to redis.stream.consumer.idle metric.
+func (mb *MetricsBuilder) RecordRedisStreamConsumerIdleDataPoint(ts pdata.Timestamp, val int64, dbAttributeValue string, streamAttributeValue string, groupAttributeValue string, consumerAttributeValue string) {
+	mb.metricRedisStreamConsumerIdle.recordDataPoint(mb.startTime, ts, val, dbAttributeValue, streamAttributeValue, groupAttributeValue, consumerAttributeValue)
+}
+
+// RecordRedisStreamConsumerPendingDataPoint adds a data point to redis.stream.consumer.pending metric.
+func (mb *MetricsBuilder) RecordRedisStreamConsumerPendingDataPoint(ts pdata.Timestamp, val int64, dbAttributeValue string, streamAttributeValue string, groupAttributeValue string, consumerAttributeValue string) {
+	mb.metricRedisStreamConsumerPending.recordDataPoint(mb.startTime, ts, val, dbAttributeValue, streamAttributeValue, groupAttributeValue, consumerAttributeValue)
+}
+
+// RecordRedisStreamGroupLagDataPoint adds a data point to redis.stream.group.lag metric.
+func (mb *MetricsBuilder) RecordRedisStreamGroupLagDataPoint(ts pdata.Timestamp, val int64, dbAttributeValue string, streamAttributeValue string, groupAttributeValue string) {
+	mb.metricRedisStreamGroupLag.recordDataPoint(mb.startTime, ts, val, dbAttributeValue, streamAttributeValue, groupAttributeValue)
+}
+
+// RecordRedisStreamGroupLastDeliveredAgeDataPoint adds a data point to redis.stream.group.last_delivered_age metric.
+func (mb *MetricsBuilder) RecordRedisStreamGroupLastDeliveredAgeDataPoint(ts pdata.Timestamp, val int64, dbAttributeValue string, streamAttributeValue string, groupAttributeValue string) {
+	mb.metricRedisStreamGroupLastDeliveredAge.recordDataPoint(mb.startTime, ts, val, dbAttributeValue, streamAttributeValue, groupAttributeValue)
+}
+
+// RecordRedisStreamGroupPendingDataPoint adds a data point to redis.stream.group.pending metric.
+func (mb *MetricsBuilder) RecordRedisStreamGroupPendingDataPoint(ts pdata.Timestamp, val int64, dbAttributeValue string, streamAttributeValue string, groupAttributeValue string) {
+	mb.metricRedisStreamGroupPending.recordDataPoint(mb.startTime, ts, val, dbAttributeValue, streamAttributeValue, groupAttributeValue)
+}
+
+// RecordRedisUpDataPoint adds a data point to redis.up metric.
+func (mb *MetricsBuilder) RecordRedisUpDataPoint(ts pdata.Timestamp, val int64) {
+	mb.metricRedisUp.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordRedisUptimeDataPoint adds a data point to redis.uptime metric.
 func (mb *MetricsBuilder) RecordRedisUptimeDataPoint(ts pdata.Timestamp, val int64) {
 	mb.metricRedisUptime.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordRedisWatchedKeyLengthDataPoint adds a data point to redis.watched_key.length metric.
+func (mb *MetricsBuilder) RecordRedisWatchedKeyLengthDataPoint(ts pdata.Timestamp, val int64, dbAttributeValue string, keyAttributeValue string, keyTypeAttributeValue string) {
+	mb.metricRedisWatchedKeyLength.recordDataPoint(mb.startTime, ts, val, dbAttributeValue, keyAttributeValue, keyTypeAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
@@ -2573,16 +5660,73 @@ func (mb *MetricsBuilder) Reset(options ...metricBuilderOption) {
 
 // Attributes contains the possible metric attributes that can be used.
 var Attributes = struct {
+	// Category (Category of the error that failed the scrape, one of "connection_refused", "timeout", "auth", "tls", "parse" or "other")
+	Category string
+	// Channel (Name of the Pub/Sub channel)
+	Channel string
+	// ClientDb (Database selected by the client connections, if grouped by db)
+	ClientDb string
+	// ClientFlags (CLIENT LIST flags of the client connections, e.g. "N" or "b", if grouped by flags)
+	ClientFlags string
+	// ClientLibName (Name of the client library of the connections (Redis 7.2+), if grouped by library name)
+	ClientLibName string
+	// ClientName (Name of the client connections set with CLIENT SETNAME, if grouped by name)
+	ClientName string
+	// ClientUser (ACL user of the client connections, if grouped by user)
+	ClientUser string
 	// Command (Redis command identifier)
 	Command string
+	// Consumer (Name of the consumer of the stream consumer group)
+	Consumer string
 	// Db (Redis database identifier)
 	Db string
+	// ErrorPrefix (Prefix of the error replies, e.g. "ERR", "WRONGTYPE" or "OOM")
+	ErrorPrefix string
+	// Group (Name of the stream consumer group)
+	Group string
+	// Key (Name of a sampled or watched key)
+	Key string
+	// KeyType (Type of a sampled or watched key, e.g. "string", "hash" or "zset", "none" if it does not exist)
+	KeyType string
+	// Parameter (Name of the server configuration parameter)
+	Parameter string
+	// ParameterValue (Value of the server configuration parameter)
+	ParameterValue string
+	// Pattern (Name of the configured key pattern)
+	Pattern string
+	// Probe (Kind of probe, "ping" for PING or "canary" for writing and reading back the canary key)
+	Probe string
+	// Replica (Address of the replica, as ip:port)
+	Replica string
+	// ReplicaState (Replication state of the replica, e.g. "wait_bgsave", "send_bulk" or "online")
+	ReplicaState string
 	// State (Redis CPU usage state)
 	State string
+	// Stream (Name of the stream key)
+	Stream string
 }{
+	"category",
+	"channel",
+	"client_db",
+	"client_flags",
+	"client_lib_name",
+	"client_name",
+	"client_user",
 	"command",
+	"consumer",
 	"db",
+	"error_prefix",
+	"group",
+	"key",
+	"type",
+	"parameter",
+	"value",
+	"pattern",
+	"probe",
+	"replica",
+	"state",
 	"state",
+	"stream",
 }
 
 // A is an alias for Attributes.
@@ -0,0 +1,244 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+
+import "go.opentelemetry.io/collector/model/pdata"
+
+// The version of mdatagen this module builds with only documents the
+// resource_attributes of metadata.yaml, so the settings and setters of the
+// resource attributes are written by hand below. Keep them in sync with
+// metadata.yaml.
+
+// ResourceAttributeSettings provides common settings for a particular resource attribute.
+type ResourceAttributeSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// ResourceAttributesSettings provides settings for redisreceiver resource attributes.
+type ResourceAttributesSettings struct {
+	RedisClusterNodeAddress  ResourceAttributeSettings `mapstructure:"redis.cluster.node.address"`
+	RedisClusterNodeID       ResourceAttributeSettings `mapstructure:"redis.cluster.node.id"`
+	RedisClusterNodeRole     ResourceAttributeSettings `mapstructure:"redis.cluster.node.role"`
+	RedisClusterNodeSlots    ResourceAttributeSettings `mapstructure:"redis.cluster.node.slots"`
+	RedisEndpoint            ResourceAttributeSettings `mapstructure:"redis.endpoint"`
+	RedisMaxmemoryPolicy     ResourceAttributeSettings `mapstructure:"redis.maxmemory_policy"`
+	RedisMode                ResourceAttributeSettings `mapstructure:"redis.mode"`
+	RedisOs                  ResourceAttributeSettings `mapstructure:"redis.os"`
+	RedisRole                ResourceAttributeSettings `mapstructure:"redis.role"`
+	RedisRunID               ResourceAttributeSettings `mapstructure:"redis.run_id"`
+	RedisSentinelMasterName  ResourceAttributeSettings `mapstructure:"redis.sentinel.master_name"`
+	RedisSentinelNodeAddress ResourceAttributeSettings `mapstructure:"redis.sentinel.node.address"`
+	RedisSentinelNodeRole    ResourceAttributeSettings `mapstructure:"redis.sentinel.node.role"`
+	RedisTCPPort             ResourceAttributeSettings `mapstructure:"redis.tcp_port"`
+	RedisVersion             ResourceAttributeSettings `mapstructure:"redis.version"`
+}
+
+// DefaultResourceAttributesSettings enables every resource attribute but
+// redis.os and redis.run_id.
+func DefaultResourceAttributesSettings() ResourceAttributesSettings {
+	return ResourceAttributesSettings{
+		RedisClusterNodeAddress: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		RedisClusterNodeID: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		RedisClusterNodeRole: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		RedisClusterNodeSlots: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		RedisEndpoint: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		RedisMaxmemoryPolicy: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		RedisMode: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		RedisOs: ResourceAttributeSettings{
+			Enabled: false,
+		},
+		RedisRole: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		RedisRunID: ResourceAttributeSettings{
+			Enabled: false,
+		},
+		RedisSentinelMasterName: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		RedisSentinelNodeAddress: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		RedisSentinelNodeRole: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		RedisTCPPort: ResourceAttributeSettings{
+			Enabled: true,
+		},
+		RedisVersion: ResourceAttributeSettings{
+			Enabled: true,
+		},
+	}
+}
+
+// ResourceOption sets a resource attribute, unless it is disabled in the settings.
+type ResourceOption func(ResourceAttributesSettings, pdata.AttributeMap)
+
+// WithRedisClusterNodeAddress sets provided value as "redis.cluster.node.address" attribute for current resource.
+func WithRedisClusterNodeAddress(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisClusterNodeAddress.Enabled {
+			attrs.UpsertString("redis.cluster.node.address", val)
+		}
+	}
+}
+
+// WithRedisClusterNodeID sets provided value as "redis.cluster.node.id" attribute for current resource.
+func WithRedisClusterNodeID(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisClusterNodeID.Enabled {
+			attrs.UpsertString("redis.cluster.node.id", val)
+		}
+	}
+}
+
+// WithRedisClusterNodeRole sets provided value as "redis.cluster.node.role" attribute for current resource.
+func WithRedisClusterNodeRole(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisClusterNodeRole.Enabled {
+			attrs.UpsertString("redis.cluster.node.role", val)
+		}
+	}
+}
+
+// WithRedisClusterNodeSlots sets provided value as "redis.cluster.node.slots" attribute for current resource.
+func WithRedisClusterNodeSlots(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisClusterNodeSlots.Enabled {
+			attrs.UpsertString("redis.cluster.node.slots", val)
+		}
+	}
+}
+
+// WithRedisEndpoint sets provided value as "redis.endpoint" attribute for current resource.
+func WithRedisEndpoint(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisEndpoint.Enabled {
+			attrs.UpsertString("redis.endpoint", val)
+		}
+	}
+}
+
+// WithRedisMaxmemoryPolicy sets provided value as "redis.maxmemory_policy" attribute for current resource.
+func WithRedisMaxmemoryPolicy(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisMaxmemoryPolicy.Enabled {
+			attrs.UpsertString("redis.maxmemory_policy", val)
+		}
+	}
+}
+
+// WithRedisMode sets provided value as "redis.mode" attribute for current resource.
+func WithRedisMode(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisMode.Enabled {
+			attrs.UpsertString("redis.mode", val)
+		}
+	}
+}
+
+// WithRedisOs sets provided value as "redis.os" attribute for current resource.
+func WithRedisOs(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisOs.Enabled {
+			attrs.UpsertString("redis.os", val)
+		}
+	}
+}
+
+// WithRedisRole sets provided value as "redis.role" attribute for current resource.
+func WithRedisRole(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisRole.Enabled {
+			attrs.UpsertString("redis.role", val)
+		}
+	}
+}
+
+// WithRedisRunID sets provided value as "redis.run_id" attribute for current resource.
+func WithRedisRunID(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisRunID.Enabled {
+			attrs.UpsertString("redis.run_id", val)
+		}
+	}
+}
+
+// WithRedisSentinelMasterName sets provided value as "redis.sentinel.master_name" attribute for current resource.
+func WithRedisSentinelMasterName(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisSentinelMasterName.Enabled {
+			attrs.UpsertString("redis.sentinel.master_name", val)
+		}
+	}
+}
+
+// WithRedisSentinelNodeAddress sets provided value as "redis.sentinel.node.address" attribute for current resource.
+func WithRedisSentinelNodeAddress(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisSentinelNodeAddress.Enabled {
+			attrs.UpsertString("redis.sentinel.node.address", val)
+		}
+	}
+}
+
+// WithRedisSentinelNodeRole sets provided value as "redis.sentinel.node.role" attribute for current resource.
+func WithRedisSentinelNodeRole(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisSentinelNodeRole.Enabled {
+			attrs.UpsertString("redis.sentinel.node.role", val)
+		}
+	}
+}
+
+// WithRedisTCPPort sets provided value as "redis.tcp_port" attribute for current resource.
+func WithRedisTCPPort(val int64) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisTCPPort.Enabled {
+			attrs.UpsertInt("redis.tcp_port", val)
+		}
+	}
+}
+
+// WithRedisVersion sets provided value as "redis.version" attribute for current resource.
+func WithRedisVersion(val string) ResourceOption {
+	return func(s ResourceAttributesSettings, attrs pdata.AttributeMap) {
+		if s.RedisVersion.Enabled {
+			attrs.UpsertString("redis.version", val)
+		}
+	}
+}
+
+// SetResourceAttributes sets the provided attributes on a resource, skipping those disabled in the settings.
+func (s ResourceAttributesSettings) SetResourceAttributes(r pdata.Resource, ro ...ResourceOption) {
+	attrs := r.Attributes()
+	for _, op := range ro {
+		op(s, attrs)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/model/pdata"
+	"gopkg.in/yaml.v3"
+)
+
+func TestResourceAttributesSettingsMatchMetadata(t *testing.T) {
+	data, err := ioutil.ReadFile("../../metadata.yaml")
+	require.NoError(t, err)
+	var md struct {
+		ResourceAttributes map[string]interface{} `yaml:"resource_attributes"`
+	}
+	require.NoError(t, yaml.Unmarshal(data, &md))
+
+	var declared []string
+	for name := range md.ResourceAttributes {
+		declared = append(declared, name)
+	}
+	var settings []string
+	typ := reflect.TypeOf(ResourceAttributesSettings{})
+	for i := 0; i < typ.NumField(); i++ {
+		settings = append(settings, typ.Field(i).Tag.Get("mapstructure"))
+	}
+	assert.ElementsMatch(t, declared, settings)
+}
+
+func TestSetResourceAttributes(t *testing.T) {
+	settings := DefaultResourceAttributesSettings()
+	r := pdata.NewResource()
+	settings.SetResourceAttributes(r, WithRedisEndpoint("localhost:6379"), WithRedisRunID("abc"), WithRedisTCPPort(6379))
+	assert.Equal(t, map[string]interface{}{
+		"redis.endpoint": "localhost:6379",
+		"redis.tcp_port": int64(6379),
+	}, r.Attributes().AsRaw())
+
+	settings.RedisEndpoint.Enabled = false
+	settings.RedisRunID.Enabled = true
+	r = pdata.NewResource()
+	settings.SetResourceAttributes(r, WithRedisEndpoint("localhost:6379"), WithRedisRunID("abc"))
+	assert.Equal(t, map[string]interface{}{"redis.run_id": "abc"}, r.Attributes().AsRaw())
+}
@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// globToRegexp translates a Redis glob-style pattern, as used by KEYS and
+// SCAN MATCH, into an anchored regular expression. Unlike path.Match, '*'
+// also matches '/' and other separators.
+func globToRegexp(glob string) (string, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		case '\\':
+			if i+1 == len(glob) {
+				return "", fmt.Errorf("trailing escape in pattern '%s'", glob)
+			}
+			i++
+			b.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated character class in pattern '%s'", glob)
+			}
+			class := glob[i+1 : i+1+end]
+			b.WriteString("[")
+			if strings.HasPrefix(class, "^") {
+				b.WriteString("^")
+				class = class[1:]
+			}
+			b.WriteString(strings.NewReplacer(`\`, `\\`, `[`, `\[`).Replace(class))
+			b.WriteString("]")
+			i += end + 1
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return b.String(), nil
+}
+
+// A configured pattern keys are matched against.
+type keyPattern struct {
+	name string
+	re   *regexp.Regexp
+}
+
+// compileKeyPatterns compiles the configured glob and regex patterns.
+func compileKeyPatterns(settings []KeyPatternSettings) ([]*keyPattern, error) {
+	patterns := make([]*keyPattern, 0, len(settings))
+	for _, s := range settings {
+		expr := s.Regex
+		name := s.Name
+		switch {
+		case s.Glob != "" && s.Regex != "":
+			return nil, fmt.Errorf("key pattern %q must have either a glob or a regex, not both", s.Name)
+		case s.Glob != "":
+			var err error
+			if expr, err = globToRegexp(s.Glob); err != nil {
+				return nil, err
+			}
+			if name == "" {
+				name = s.Glob
+			}
+		case s.Regex != "":
+			if name == "" {
+				name = s.Regex
+			}
+		default:
+			return nil, errors.New("key pattern must have a glob or a regex")
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key pattern %q: %w", name, err)
+		}
+		patterns = append(patterns, &keyPattern{name: name, re: re})
+	}
+	return patterns, nil
+}
+
+// Holds the keys matching a pattern.
+type keyPatternTotals struct {
+	count int64
+	bytes int64
+}
+
+// Tracks the walk of a database across scrapes.
+type keyPatternDB struct {
+	scanCursor
+	// totals of the walk in progress, by pattern name
+	current map[string]*keyPatternTotals
+	// totals of the last complete walk, nil until one completes
+	complete map[string]*keyPatternTotals
+}
+
+// Walks the keyspace of every database a batch at a time on each scrape,
+// counting the keys and memory matching each pattern.
+type keyPatternWalker struct {
+	patterns []*keyPattern
+	dbs      map[int]*keyPatternDB
+}
+
+func newKeyPatternWalker(patterns []*keyPattern) *keyPatternWalker {
+	return &keyPatternWalker{patterns: patterns, dbs: map[int]*keyPatternDB{}}
+}
+
+func newKeyPatternTotals(patterns []*keyPattern) map[string]*keyPatternTotals {
+	totals := make(map[string]*keyPatternTotals, len(patterns))
+	for _, p := range patterns {
+		totals[p.name] = &keyPatternTotals{}
+	}
+	return totals
+}
+
+// walk scans up to keys_per_scrape keys of each of the databases, each
+// database getting a share of the time budget.
+func (w *keyPatternWalker) walk(c client, dbs []int, settings KeyPatternsSettings) error {
+	deadline := time.Now().Add(settings.TimeBudget)
+	present := make(map[int]bool, len(dbs))
+	var errs error
+	for i, db := range dbs {
+		present[db] = true
+		state, ok := w.dbs[db]
+		if !ok {
+			state = &keyPatternDB{current: newKeyPatternTotals(w.patterns)}
+			w.dbs[db] = state
+		}
+		completed, err := state.advance(func(cursor uint64, count int64) (uint64, error) {
+			samples, next, err := c.measureKeys(db, cursor, count)
+			if err != nil {
+				return 0, err
+			}
+			for _, sample := range samples {
+				// A key is counted under every pattern it matches.
+				for _, p := range w.patterns {
+					if p.re.MatchString(sample.key) {
+						state.current[p.name].count++
+						state.current[p.name].bytes += sample.memory
+					}
+				}
+			}
+			return next, nil
+		}, settings.KeysPerScrape, settings.ScanCount, shareBudget(deadline, len(dbs)-i))
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("db %d: %w", db, err))
+		}
+		if completed {
+			state.complete, state.current = state.current, newKeyPatternTotals(w.patterns)
+		}
+	}
+	// Databases that became empty are forgotten.
+	for db := range w.dbs {
+		if !present[db] {
+			delete(w.dbs, db)
+		}
+	}
+	return errs
+}
+
+// recordKeyPatternMetrics walks the keyspace if patterns are configured and
+// records the totals of the last complete walk of each database.
+func (rs *redisScraper) recordKeyPatternMetrics(ts pdata.Timestamp, inf info) {
+	if len(rs.cfg.KeyPatterns.Patterns) == 0 {
+		return
+	}
+	if rs.keyPatterns == nil {
+		patterns, err := compileKeyPatterns(rs.cfg.KeyPatterns.Patterns)
+		if err != nil {
+			// Already checked by Config.Validate.
+			rs.settings.Logger.Warn("failed to compile key patterns", zap.Error(err))
+			return
+		}
+		rs.keyPatterns = newKeyPatternWalker(patterns)
+	}
+
+	if err := rs.keyPatterns.walk(rs.redisSvc.client, inf.keyspaceDBs(), rs.cfg.KeyPatterns); err != nil {
+		rs.errs.AddPartial(1, fmt.Errorf("failed to walk keyspace: %w", err))
+	}
+
+	walked := make([]int, 0, len(rs.keyPatterns.dbs))
+	for db := range rs.keyPatterns.dbs {
+		walked = append(walked, db)
+	}
+	sort.Ints(walked)
+	for _, db := range walked {
+		state := rs.keyPatterns.dbs[db]
+		if state.complete == nil {
+			continue
+		}
+		dbStr := strconv.Itoa(db)
+		for _, p := range rs.keyPatterns.patterns {
+			totals := state.complete[p.name]
+			rs.mb.RecordRedisKeysPatternCountDataPoint(ts, totals.count, dbStr, p.name)
+			rs.mb.RecordRedisKeysPatternMemoryUsageDataPoint(ts, totals.bytes, dbStr, p.name)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestGlobToRegexp(t *testing.T) {
+	tests := []struct {
+		glob    string
+		matches []string
+		misses  []string
+	}{
+		{glob: "session:*", matches: []string{"session:", "session:a/b"}, misses: []string{"sessions:a", "x:session:a"}},
+		{glob: "cache:user:?", matches: []string{"cache:user:1"}, misses: []string{"cache:user:12"}},
+		{glob: "h[ae]llo", matches: []string{"hello", "hallo"}, misses: []string{"hillo"}},
+		{glob: "h[^e]llo", matches: []string{"hallo"}, misses: []string{"hello"}},
+		{glob: "h[a-b]llo", matches: []string{"hbllo"}, misses: []string{"hcllo"}},
+		{glob: `a\*b.c`, matches: []string{"a*b.c"}, misses: []string{"axb.c", "a*bxc"}},
+	}
+	for _, test := range tests {
+		expr, err := globToRegexp(test.glob)
+		require.NoError(t, err, test.glob)
+		re := regexp.MustCompile(expr)
+		for _, key := range test.matches {
+			assert.True(t, re.MatchString(key), "%s should match %s", test.glob, key)
+		}
+		for _, key := range test.misses {
+			assert.False(t, re.MatchString(key), "%s should not match %s", test.glob, key)
+		}
+	}
+
+	_, err := globToRegexp("h[ello")
+	require.Error(t, err)
+	_, err = globToRegexp(`hello\`)
+	require.Error(t, err)
+}
+
+func TestCompileKeyPatterns(t *testing.T) {
+	patterns, err := compileKeyPatterns([]KeyPatternSettings{
+		{Glob: "session:*"},
+		{Name: "users", Regex: "^user:[0-9]+$"},
+	})
+	require.NoError(t, err)
+	require.Len(t, patterns, 2)
+	assert.Equal(t, "session:*", patterns[0].name)
+	assert.Equal(t, "users", patterns[1].name)
+
+	_, err = compileKeyPatterns([]KeyPatternSettings{{Name: "empty"}})
+	require.Error(t, err)
+	_, err = compileKeyPatterns([]KeyPatternSettings{{Glob: "a*", Regex: "a.*"}})
+	require.Error(t, err)
+	_, err = compileKeyPatterns([]KeyPatternSettings{{Regex: "("}})
+	require.Error(t, err)
+}
+
+func TestKeyPatternWalker(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.KeyPatterns.KeysPerScrape = 40
+	cfg.KeyPatterns.ScanCount = 10
+	cfg.KeyPatterns.TimeBudget = time.Minute
+	patterns, err := compileKeyPatterns([]KeyPatternSettings{{Glob: "key:1*"}, {Glob: "key:*"}})
+	require.NoError(t, err)
+
+	c := newKeysFakeClient()
+	w := newKeyPatternWalker(patterns)
+	require.NoError(t, w.walk(c, []int{0}, cfg.KeyPatterns))
+	assert.Equal(t, 40, c.scanned)
+	// nothing is known until a walk completes
+	assert.Nil(t, w.dbs[0].complete)
+
+	require.NoError(t, w.walk(c, []int{0}, cfg.KeyPatterns))
+	require.NoError(t, w.walk(c, []int{0}, cfg.KeyPatterns))
+	require.NotNil(t, w.dbs[0].complete)
+	// key:1 and key:10 to key:19
+	assert.Equal(t, int64(11), w.dbs[0].complete["key:1*"].count)
+	assert.Equal(t, int64(100), w.dbs[0].complete["key:*"].count)
+	assert.Equal(t, int64(495000), w.dbs[0].complete["key:*"].bytes)
+
+	// the complete walk is kept while the next one is in progress
+	require.NoError(t, w.walk(c, []int{0}, cfg.KeyPatterns))
+	assert.Equal(t, int64(100), w.dbs[0].complete["key:*"].count)
+	assert.Equal(t, int64(40), w.dbs[0].current["key:*"].count)
+}
+
+func TestKeyPatternWalkerUnreadableMemory(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.KeyPatterns.KeysPerScrape = 100
+	cfg.KeyPatterns.TimeBudget = time.Minute
+	patterns, err := compileKeyPatterns([]KeyPatternSettings{{Glob: "key:*"}})
+	require.NoError(t, err)
+
+	c := newKeysFakeClient()
+	// e.g. deleted since the scan
+	c.keys[99].keyType = "unreadable"
+	w := newKeyPatternWalker(patterns)
+	require.NoError(t, w.walk(c, []int{0}, cfg.KeyPatterns))
+	require.NotNil(t, w.dbs[0].complete)
+	assert.Equal(t, int64(100), w.dbs[0].complete["key:*"].count)
+	assert.Equal(t, int64(495000-9900), w.dbs[0].complete["key:*"].bytes)
+}
+
+func TestKeyPatternWalkerTimeBudget(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.KeyPatterns.KeysPerScrape = 100
+	cfg.KeyPatterns.ScanCount = 10
+	cfg.KeyPatterns.TimeBudget = 40 * time.Millisecond
+	patterns, err := compileKeyPatterns([]KeyPatternSettings{{Glob: "key:*"}})
+	require.NoError(t, err)
+
+	// a large database does not use up the budget of the others
+	c := newKeysFakeClient()
+	c.delay = 10 * time.Millisecond
+	w := newKeyPatternWalker(patterns)
+	require.NoError(t, w.walk(c, []int{0, 1}, cfg.KeyPatterns))
+	assert.Less(t, c.scanned, 100)
+	assert.True(t, c.dbs[1])
+	assert.NotNil(t, w.dbs[1].complete)
+
+	// a failing database does not stop the walk of the others
+	c = newKeysFakeClient()
+	c.err = errors.New("ERR command not allowed")
+	w.dbs[2] = &keyPatternDB{}
+	err = w.walk(c, []int{0, 1}, cfg.KeyPatterns)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "db 0")
+	assert.True(t, c.dbs[1])
+	assert.NotContains(t, w.dbs, 2)
+}
+
+func TestRedisScraperKeyPatterns(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.KeyPatterns.Patterns = []KeyPatternSettings{{Name: "all", Glob: "*"}}
+	cfg.KeyPatterns.TimeBudget = time.Minute
+	runner, err := newRedisScraperWithClient(newKeysFakeClient(), componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+
+	m, ok := findMetric(md, "redis.keys.pattern.count")
+	require.True(t, ok)
+	// db0 has all the keys of the fake client, db1 none
+	require.Equal(t, 2, m.Gauge().DataPoints().Len())
+	assert.Equal(t, map[string]interface{}{"db": "0", "pattern": "all"}, m.Gauge().DataPoints().At(0).Attributes().AsRaw())
+	assert.Equal(t, int64(100), m.Gauge().DataPoints().At(0).IntVal())
+	assert.Equal(t, int64(0), m.Gauge().DataPoints().At(1).IntVal())
+	_, ok = findMetric(md, "redis.keys.pattern.memory_usage")
+	assert.True(t, ok)
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"time"
+)
+
+// Tracks the cursor of a SCAN of the keyspace of a database across scrapes,
+// so that a large keyspace is covered a batch at a time and never weighs on
+// the server.
+type scanCursor struct {
+	cursor uint64
+}
+
+// advance scans up to quota keys in batches of count, stopping once the
+// deadline passes, and reports whether the scan completed, the next one
+// starting over. scan returns the next cursor, 0 once the cursor wraps around
+// or to complete the scan early.
+func (s *scanCursor) advance(scan func(cursor uint64, count int64) (uint64, error), quota int, count int, deadline time.Time) (bool, error) {
+	for quota > 0 && !time.Now().After(deadline) {
+		if quota < count {
+			count = quota
+		}
+		next, err := scan(s.cursor, int64(count))
+		if err != nil {
+			return false, err
+		}
+		// SCAN may return fewer or more keys than asked for.
+		quota -= count
+		s.cursor = next
+		if next == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// shareBudget returns the deadline of the next of remaining scans sharing the
+// time left until deadline, so that every database gets its share however
+// large the ones scanned before it are, and the time a scan does not use goes
+// to the scans after it.
+func shareBudget(deadline time.Time, remaining int) time.Time {
+	return time.Now().Add(time.Until(deadline) / time.Duration(remaining))
+}
+
+// Collects the keys found by a scanCursor, e.g. those matching a pattern.
+type keyScan struct {
+	scanCursor
+	// keys found by the scan in progress
+	current []string
+	// keys found by the last complete scan, nil until one completes
+	complete []string
+}
+
+// keys returns the keys found by the last complete scan, or those found so far
+// until the first scan completes.
+func (s *keyScan) keys() []string {
+	if s.complete != nil {
+		return s.complete
+	}
+	return s.current
+}
+
+// advance scans up to quota keys, completing the scan early once it found max
+// keys.
+func (s *keyScan) advance(scan func(cursor uint64, count int64) ([]string, uint64, error), max int, quota int, count int, deadline time.Time) error {
+	completed, err := s.scanCursor.advance(func(cursor uint64, count int64) (uint64, error) {
+		keys, next, err := scan(cursor, count)
+		if err != nil {
+			return 0, err
+		}
+		s.current = append(s.current, keys...)
+		if len(s.current) >= max {
+			return 0, nil
+		}
+		return next, nil
+	}, quota, count, deadline)
+	if completed {
+		if len(s.current) > max {
+			s.current = s.current[:max]
+		}
+		s.complete = append([]string{}, s.current...)
+		s.current = nil
+	}
+	return err
+}
@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyScan(t *testing.T) {
+	client := &watchedKeysFakeClient{dbs: map[int]map[string]*keySample{0: {
+		"a:q": {}, "b": {}, "c:q": {}, "d": {}, "e": {}, "f:q": {},
+	}}}
+	scan := func(cursor uint64, count int64) ([]string, uint64, error) {
+		return client.matchKeys(0, "*:q", cursor, count)
+	}
+	deadline := time.Now().Add(time.Minute)
+
+	s := &keyScan{}
+	// the keys found so far are used until the first scan completes
+	require.NoError(t, s.advance(scan, 100, 3, 2, deadline))
+	assert.Equal(t, 3, client.scanned)
+	assert.Equal(t, []string{"a:q", "c:q"}, s.keys())
+	require.NoError(t, s.advance(scan, 100, 3, 2, deadline))
+	assert.Equal(t, 6, client.scanned)
+	assert.Equal(t, []string{"a:q", "c:q", "f:q"}, s.keys())
+
+	// the next scan starts over, the previous keys being kept in the meantime
+	delete(client.dbs[0], "c:q")
+	require.NoError(t, s.advance(scan, 100, 3, 2, deadline))
+	assert.Equal(t, []string{"a:q", "c:q", "f:q"}, s.keys())
+	require.NoError(t, s.advance(scan, 100, 3, 2, deadline))
+	assert.Equal(t, []string{"a:q", "f:q"}, s.keys())
+
+	// reaching max completes the scan early
+	s = &keyScan{}
+	client.scanned = 0
+	require.NoError(t, s.advance(scan, 1, 100, 2, deadline))
+	assert.Equal(t, []string{"a:q"}, s.keys())
+	assert.Equal(t, 2, client.scanned)
+
+	// nothing is scanned past the deadline
+	client.scanned = 0
+	require.NoError(t, s.advance(scan, 100, 100, 2, time.Now().Add(-time.Second)))
+	assert.Equal(t, 0, client.scanned)
+}
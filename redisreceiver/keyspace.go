@@ -21,12 +21,14 @@ import (
 )
 
 // Holds fields returned by the Keyspace section of the INFO command: e.g.
-// "db0:keys=1,expires=2,avg_ttl=3"
+// "db0:keys=1,expires=2,avg_ttl=3,subexpiry=0"
 type keyspace struct {
-	db      string
-	keys    int
-	expires int
-	avgTTL  int
+	db           string
+	keys         int
+	expires      int
+	avgTTL       int
+	subexpiry    int
+	hasSubexpiry bool // only reported by Redis 7.4 and later
 }
 
 // Turns a keyspace value (the part after the colon
@@ -51,6 +53,9 @@ func parseKeyspaceString(db int, str string) (*keyspace, error) {
 			field = &ks.expires
 		case "avg_ttl":
 			field = &ks.avgTTL
+		case "subexpiry":
+			field = &ks.subexpiry
+			ks.hasSubexpiry = true
 		}
 		if field != nil {
 			val, err := strconv.Atoi(pair[1])
@@ -27,6 +27,15 @@ func TestParseKeyspace(t *testing.T) {
 	require.Equal(t, 1, ks.keys)
 	require.Equal(t, 2, ks.expires)
 	require.Equal(t, 3, ks.avgTTL)
+	require.False(t, ks.hasSubexpiry)
+}
+
+func TestParseKeyspaceWithSubexpiry(t *testing.T) {
+	ks, err := parseKeyspaceString(0, "keys=1,expires=2,avg_ttl=3,subexpiry=4")
+	require.Nil(t, err)
+	require.Equal(t, 1, ks.keys)
+	require.True(t, ks.hasSubexpiry)
+	require.Equal(t, 4, ks.subexpiry)
 }
 
 func TestParseMalformedKeyspace(t *testing.T) {
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// Holds the LATENCY HISTOGRAM reply for a single command: e.g.
+// "set" => ["calls", 100000, "histogram_usec", [1, 99583, 2, 99852, 4, 100000]]
+type latencyHistogram struct {
+	command string
+	calls   int64
+	// cumulative number of calls that took at most the bucket's latency,
+	// only listed for buckets holding calls
+	buckets []latencyBucket
+}
+
+type latencyBucket struct {
+	usec            int64
+	cumulativeCount int64
+}
+
+// Redis keeps latencies in an hdr histogram with 2 significant figures, and
+// reports the buckets doubling from 1024ns up to about 1s by the highest
+// value equivalent to their bound, truncated to microseconds: 1, 2, 4, 8, 16,
+// 33, 66, 132, ... The equivalent values of a bound 1024<<k span 8<<k
+// nanoseconds. These are used as the explicit bounds of every histogram so
+// that histograms from different servers and scrapes can be aggregated.
+var latencyHistogramBounds = func() []float64 {
+	bounds := make([]float64, 0, 21)
+	for k := 0; k <= 20; k++ {
+		highest := int64(1024+8)<<k - 1
+		bounds = append(bounds, float64(highest/1000))
+	}
+	return bounds
+}()
+
+// Turns a LATENCY HISTOGRAM reply, alternating command names and per-command
+// field/value lists, into one latencyHistogram per command.
+func parseLatencyHistogram(vals []interface{}) ([]*latencyHistogram, error) {
+	if len(vals)%2 != 0 {
+		return nil, fmt.Errorf("unexpected latency histogram reply '%v'", vals)
+	}
+	histograms := make([]*latencyHistogram, 0, len(vals)/2)
+	for i := 0; i < len(vals); i += 2 {
+		command, commandOk := vals[i].(string)
+		fields, fieldsOk := vals[i+1].([]interface{})
+		if !commandOk || !fieldsOk || len(fields)%2 != 0 {
+			return nil, fmt.Errorf("unexpected latency histogram entry '%v=%v'", vals[i], vals[i+1])
+		}
+
+		h := &latencyHistogram{command: command}
+		for j := 0; j < len(fields); j += 2 {
+			switch fields[j] {
+			case "calls":
+				calls, ok := fields[j+1].(int64)
+				if !ok {
+					return nil, fmt.Errorf("unexpected calls '%v' for command '%s'", fields[j+1], command)
+				}
+				h.calls = calls
+			case "histogram_usec":
+				buckets, ok := fields[j+1].([]interface{})
+				if !ok || len(buckets)%2 != 0 {
+					return nil, fmt.Errorf("unexpected histogram '%v' for command '%s'", fields[j+1], command)
+				}
+				for k := 0; k < len(buckets); k += 2 {
+					usec, usecOk := buckets[k].(int64)
+					count, countOk := buckets[k+1].(int64)
+					if !usecOk || !countOk {
+						return nil, fmt.Errorf("unexpected histogram bucket '%v=%v' for command '%s'", buckets[k], buckets[k+1], command)
+					}
+					h.buckets = append(h.buckets, latencyBucket{usec: usec, cumulativeCount: count})
+				}
+			}
+		}
+		histograms = append(histograms, h)
+	}
+	return histograms, nil
+}
+
+// bucketCounts spreads the cumulative counts Redis reports over
+// latencyHistogramBounds, returning the per-bucket counts including the
+// overflow bucket.
+func (h *latencyHistogram) bucketCounts() []uint64 {
+	counts := make([]uint64, len(latencyHistogramBounds)+1)
+	var previous int64
+	for _, b := range h.buckets {
+		i := sort.SearchFloat64s(latencyHistogramBounds, float64(b.usec))
+		if b.cumulativeCount > previous {
+			counts[i] += uint64(b.cumulativeCount - previous)
+			previous = b.cumulativeCount
+		}
+	}
+	if h.calls > previous {
+		counts[len(counts)-1] += uint64(h.calls - previous)
+	}
+	return counts
+}
+
+// redisMajorVersion returns the major version of the server from INFO, e.g. 7
+// for "redis_version:7.0.5", or 0 if it is unknown.
+func redisMajorVersion(inf info) int {
+	major, err := strconv.Atoi(strings.SplitN(inf["redis_version"], ".", 2)[0])
+	if err != nil {
+		return 0
+	}
+	return major
+}
+
+// recordLatencyHistogramMetrics appends a redis.command.latency histogram per
+// command from LATENCY HISTOGRAM. Servers older than Redis 7.0 do not support
+// the command and are skipped. The sum comes from the commandstats 'usec' of
+// the command as Redis does not report it with the histogram.
+func (rs *redisScraper) recordLatencyHistogramMetrics(ts pdata.Timestamp, inf info, metrics pdata.MetricSlice) {
+	if !rs.cfg.LatencyHistogram.Enabled || redisMajorVersion(inf) < 7 {
+		return
+	}
+	histograms, err := rs.redisSvc.client.retrieveLatencyHistogram()
+	if err != nil {
+		rs.errs.AddPartial(1, fmt.Errorf("failed to retrieve latency histogram: %w", err))
+		return
+	}
+	if len(histograms) == 0 {
+		return
+	}
+
+	m := metrics.AppendEmpty()
+	m.SetName("redis.command.latency")
+	m.SetDescription("Distribution of the time spent executing commands, from LATENCY HISTOGRAM (Redis 7.0+)")
+	m.SetUnit("us")
+	m.SetDataType(pdata.MetricDataTypeHistogram)
+	m.Histogram().SetAggregationTemporality(pdata.MetricAggregationTemporalityCumulative)
+	dps := m.Histogram().DataPoints()
+	dps.EnsureCapacity(len(histograms))
+	for _, h := range histograms {
+		dp := dps.AppendEmpty()
+		dp.SetStartTimestamp(rs.startTime)
+		dp.SetTimestamp(ts)
+		dp.SetCount(uint64(h.calls))
+		if cmdstat, ok := inf["cmdstat_"+h.command]; ok {
+			if stats, err := parseCommandstatString("cmdstat_"+h.command, cmdstat); err == nil {
+				dp.SetSum(float64(stats.usec))
+			}
+		}
+		dp.SetExplicitBounds(latencyHistogramBounds)
+		dp.SetBucketCounts(h.bucketCounts())
+		dp.Attributes().Insert("command", pdata.NewAttributeValueString(h.command))
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+func TestParseLatencyHistogram(t *testing.T) {
+	histograms, err := parseLatencyHistogram([]interface{}{
+		"set", []interface{}{"calls", int64(100000), "histogram_usec", []interface{}{int64(1), int64(99583), int64(2), int64(99852), int64(66), int64(100000)}},
+		"config|get", []interface{}{"calls", int64(3), "histogram_usec", []interface{}{int64(16), int64(3)}},
+	})
+	require.NoError(t, err)
+	require.Len(t, histograms, 2)
+	assert.Equal(t, &latencyHistogram{
+		command: "set",
+		calls:   100000,
+		buckets: []latencyBucket{{1, 99583}, {2, 99852}, {66, 100000}},
+	}, histograms[0])
+	assert.Equal(t, "config|get", histograms[1].command)
+
+	_, err = parseLatencyHistogram([]interface{}{"set"})
+	require.Error(t, err)
+	_, err = parseLatencyHistogram([]interface{}{"set", []interface{}{"calls", "many"}})
+	require.Error(t, err)
+}
+
+func TestLatencyHistogramBucketCounts(t *testing.T) {
+	require.Len(t, latencyHistogramBounds, 21)
+	assert.Equal(t, []float64{1, 2, 4, 8, 16, 33, 66, 132, 264, 528, 1056, 2113}, latencyHistogramBounds[:12])
+
+	h := &latencyHistogram{
+		calls:   100005,
+		buckets: []latencyBucket{{1, 99583}, {2, 99852}, {66, 100000}},
+	}
+	counts := h.bucketCounts()
+	require.Len(t, counts, len(latencyHistogramBounds)+1)
+	assert.Equal(t, []uint64{99583, 269, 0, 0, 0, 0, 148}, counts[:7])
+	// calls not covered by the buckets end up in the overflow bucket
+	assert.Equal(t, uint64(5), counts[len(counts)-1])
+
+	// every bucket Redis reports lands on its own bound
+	h = &latencyHistogram{calls: 3, buckets: []latencyBucket{{33, 1}, {2113, 3}}}
+	counts = h.bucketCounts()
+	assert.Equal(t, uint64(1), counts[5])
+	assert.Equal(t, uint64(2), counts[11])
+	assert.Equal(t, float64(1082130), latencyHistogramBounds[20])
+}
+
+func TestRedisMajorVersion(t *testing.T) {
+	assert.Equal(t, 7, redisMajorVersion(info{"redis_version": "7.0.5"}))
+	assert.Equal(t, 5, redisMajorVersion(info{"redis_version": "5.0.7"}))
+	assert.Equal(t, 0, redisMajorVersion(info{}))
+}
+
+// histogramFakeClient reports a configurable Redis version and latency histogram.
+type histogramFakeClient struct {
+	fakeClient
+	version    string
+	histograms []*latencyHistogram
+	err        error
+}
+
+func (c *histogramFakeClient) retrieveInfo([]string) (string, error) {
+	str, err := c.fakeClient.retrieveInfo(nil)
+	return strings.Replace(str, "redis_version:5.0.7", "redis_version:"+c.version, 1), err
+}
+
+func (c *histogramFakeClient) retrieveLatencyHistogram() ([]*latencyHistogram, error) {
+	return c.histograms, c.err
+}
+
+func findMetric(md pdata.Metrics, name string) (pdata.Metric, bool) {
+	ms := md.ResourceMetrics().At(0).InstrumentationLibraryMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == name {
+			return ms.At(i), true
+		}
+	}
+	return pdata.Metric{}, false
+}
+
+func TestRedisScraperLatencyHistogram(t *testing.T) {
+	histograms := []*latencyHistogram{{command: "get", calls: 5, buckets: []latencyBucket{{1, 2}, {4, 5}}}}
+	tests := []struct {
+		name     string
+		client   *histogramFakeClient
+		disabled bool
+		expected bool
+	}{
+		{name: "redis 7", client: &histogramFakeClient{version: "7.0.5", histograms: histograms}, expected: true},
+		{name: "disabled", client: &histogramFakeClient{version: "7.0.5", histograms: histograms}, disabled: true},
+		{name: "redis 6", client: &histogramFakeClient{version: "6.2.6", histograms: histograms}},
+		{name: "command rejected", client: &histogramFakeClient{version: "7.0.5", err: errors.New("ERR unknown subcommand 'histogram'")}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.LatencyHistogram.Enabled = !test.disabled
+			scraper, err := newRedisScraperWithClient(test.client, componenttest.NewNopReceiverCreateSettings(), cfg)
+			require.NoError(t, err)
+			md, err := scraper.Scrape(context.Background())
+			if test.client.err != nil {
+				require.Error(t, err)
+				assert.True(t, scrapererror.IsPartialScrapeError(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			m, ok := findMetric(md, "redis.command.latency")
+			require.Equal(t, test.expected, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, pdata.MetricAggregationTemporalityCumulative, m.Histogram().AggregationTemporality())
+			dp := m.Histogram().DataPoints().At(0)
+			assert.Equal(t, "get", dp.Attributes().AsRaw()["command"])
+			assert.Equal(t, uint64(5), dp.Count())
+			// from "cmdstat_get:calls=2,usec=4,..." in testdata/info.txt
+			assert.Equal(t, float64(4), dp.Sum())
+			assert.Equal(t, []uint64{2, 0, 3}, dp.BucketCounts()[:3])
+			assert.NotZero(t, dp.StartTimestamp())
+		})
+	}
+}
@@ -22,6 +22,10 @@ import (
 // we want to extract from Redis INFO.
 func (rs *redisScraper) dataPointRecorders() map[string]interface{} {
 	return map[string]interface{}{
+		"aof_enabled":                     rs.mb.RecordRedisAofEnabledDataPoint,
+		"aof_last_bgrewrite_status":       rs.recordAofLastBgrewriteStatus,
+		"aof_last_write_status":           rs.recordAofLastWriteStatus,
+		"aof_rewrite_in_progress":         rs.mb.RecordRedisAofRewriteInProgressDataPoint,
 		"blocked_clients":                 rs.mb.RecordRedisClientsBlockedDataPoint,
 		"client_recent_max_input_buffer":  rs.mb.RecordRedisClientsMaxInputBufferDataPoint,
 		"client_recent_max_output_buffer": rs.mb.RecordRedisClientsMaxOutputBufferDataPoint,
@@ -33,13 +37,23 @@ func (rs *redisScraper) dataPointRecorders() map[string]interface{} {
 		"keyspace_hits":                   rs.mb.RecordRedisKeyspaceHitsDataPoint,
 		"keyspace_misses":                 rs.mb.RecordRedisKeyspaceMissesDataPoint,
 		"latest_fork_usec":                rs.mb.RecordRedisLatestForkDataPoint,
+		"loading":                         rs.mb.RecordRedisPersistenceLoadingDataPoint,
 		"master_repl_offset":              rs.mb.RecordRedisReplicationOffsetDataPoint,
+		"maxmemory":                       rs.mb.RecordRedisMaxmemoryDataPoint,
 		"mem_fragmentation_ratio":         rs.mb.RecordRedisMemoryFragmentationRatioDataPoint,
+		"pubsub_channels":                 rs.mb.RecordRedisPubsubChannelsDataPoint,
+		"pubsub_patterns":                 rs.mb.RecordRedisPubsubPatternsDataPoint,
+		"rdb_bgsave_in_progress":          rs.mb.RecordRedisRdbBgsaveInProgressDataPoint,
 		"rdb_changes_since_last_save":     rs.mb.RecordRedisRdbChangesSinceLastSaveDataPoint,
+		"rdb_last_bgsave_status":          rs.recordRdbLastBgsaveStatus,
+		"rdb_last_bgsave_time_sec":        rs.mb.RecordRedisRdbLastBgsaveDurationDataPoint,
+		"rdb_last_save_time":              rs.mb.RecordRedisRdbLastSaveTimeDataPoint,
 		"rejected_connections":            rs.mb.RecordRedisConnectionsRejectedDataPoint,
 		"repl_backlog_first_byte_offset":  rs.mb.RecordRedisReplicationBacklogFirstByteOffsetDataPoint,
 		"total_commands_processed":        rs.mb.RecordRedisCommandsProcessedDataPoint,
 		"total_connections_received":      rs.mb.RecordRedisConnectionsReceivedDataPoint,
+		"total_error_replies":             rs.mb.RecordRedisErrorRepliesDataPoint,
+		"total_system_memory":             rs.mb.RecordRedisMemoryTotalSystemDataPoint,
 		"total_net_input_bytes":           rs.mb.RecordRedisNetInputDataPoint,
 		"total_net_output_bytes":          rs.mb.RecordRedisNetOutputDataPoint,
 		"uptime_in_seconds":               rs.mb.RecordRedisUptimeDataPoint,
@@ -64,3 +78,23 @@ func (rs *redisScraper) recordUsedCPUSysChildren(now pdata.Timestamp, val float6
 func (rs *redisScraper) recordUsedCPUSysUser(now pdata.Timestamp, val float64) {
 	rs.mb.RecordRedisCPUTimeDataPoint(now, val, "user")
 }
+
+func (rs *redisScraper) recordRdbLastBgsaveStatus(now pdata.Timestamp, val string) {
+	rs.mb.RecordRedisRdbLastBgsaveStatusDataPoint(now, statusValue(val))
+}
+
+func (rs *redisScraper) recordAofLastBgrewriteStatus(now pdata.Timestamp, val string) {
+	rs.mb.RecordRedisAofLastBgrewriteStatusDataPoint(now, statusValue(val))
+}
+
+func (rs *redisScraper) recordAofLastWriteStatus(now pdata.Timestamp, val string) {
+	rs.mb.RecordRedisAofLastWriteStatusDataPoint(now, statusValue(val))
+}
+
+// statusValue turns a persistence status, "ok" or "err", into 1 or 0.
+func statusValue(status string) int64 {
+	if status == "ok" {
+		return 1
+	}
+	return 0
+}
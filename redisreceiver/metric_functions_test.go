@@ -32,14 +32,14 @@ func TestDataPointRecorders(t *testing.T) {
 	settings := componenttest.NewNopReceiverCreateSettings()
 	settings.Logger = logger
 	rs := &redisScraper{
-		redisSvc: newRedisSvc(newFakeClient()),
+		redisSvc: newRedisSvc(newFakeClient(), nil),
 		settings: settings,
 		mb:       metadata.NewMetricsBuilder(Config{}.Metrics),
 	}
 	metricByRecorder := map[string]string{}
 	for metric, recorder := range rs.dataPointRecorders() {
 		switch recorder.(type) {
-		case func(pdata.Timestamp, int64), func(pdata.Timestamp, float64):
+		case func(pdata.Timestamp, int64), func(pdata.Timestamp, float64), func(pdata.Timestamp, string):
 			recorderName := runtime.FuncForPC(reflect.ValueOf(recorder).Pointer()).Name()
 			if m, ok := metricByRecorder[recorderName]; ok {
 				assert.Failf(t, "shared-recorder", "Metrics %q and %q share the same recorder", metric, m)
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+// Values of the probe attribute.
+const (
+	probePing   = "ping"
+	probeCanary = "canary"
+)
+
+// recordProbeMetrics sends PING and, if a canary key is configured and the
+// server is known not to be a replica, writes and reads back the key,
+// recording whether each probe succeeded and how long the successful ones
+// took. PING is sent even when INFO failed, inf being nil.
+func (rs *redisScraper) recordProbeMetrics(ts pdata.Timestamp, inf info) {
+	settings := rs.cfg.Probe
+	if !settings.Enabled {
+		return
+	}
+	rs.recordProbe(ts, probePing, rs.redisSvc.client.ping)
+	if role, ok := inf["role"]; settings.CanaryKey != "" && ok && role != "slave" {
+		value := strconv.FormatInt(ts.AsTime().UnixNano(), 10)
+		rs.recordProbe(ts, probeCanary, func() error {
+			return rs.redisSvc.client.probeCanary(settings.CanaryKey, value, settings.CanaryTTL)
+		})
+	}
+}
+
+func (rs *redisScraper) recordProbe(ts pdata.Timestamp, probe string, send func() error) {
+	start := time.Now()
+	if err := send(); err != nil {
+		rs.settings.Logger.Warn("probe failed", zap.String("probe", probe), zap.String("endpoint", rs.endpoint), zap.Error(err))
+		rs.mb.RecordRedisProbeSuccessDataPoint(ts, 0, probe)
+		return
+	}
+	rs.mb.RecordRedisProbeLatencyDataPoint(ts, time.Since(start).Microseconds(), probe)
+	rs.mb.RecordRedisProbeSuccessDataPoint(ts, 1, probe)
+}
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// probeFakeClient fails the probes with the configured errors and records the
+// canary writes.
+type probeFakeClient struct {
+	*replacingFakeClient
+	pingErr   error
+	canaryErr error
+	canaryKey string
+	canaryTTL time.Duration
+}
+
+func (c *probeFakeClient) ping() error {
+	return c.pingErr
+}
+
+func (c *probeFakeClient) probeCanary(key string, _ string, ttl time.Duration) error {
+	c.canaryKey, c.canaryTTL = key, ttl
+	return c.canaryErr
+}
+
+func probeValues(md pdata.Metrics, name string) map[string]int64 {
+	m, ok := findMetric(md, name)
+	if !ok {
+		return nil
+	}
+	values := map[string]int64{}
+	for i := 0; i < m.Gauge().DataPoints().Len(); i++ {
+		dp := m.Gauge().DataPoints().At(i)
+		probe, _ := dp.Attributes().Get("probe")
+		values[probe.StringVal()] = dp.IntVal()
+	}
+	return values
+}
+
+func TestRedisScraperProbe(t *testing.T) {
+	tests := []struct {
+		name            string
+		canaryKey       string
+		role            string
+		pingErr         error
+		canaryErr       error
+		expectedSuccess map[string]int64
+		expectedLatency []string
+	}{
+		{
+			name:            "ping",
+			role:            "master",
+			expectedSuccess: map[string]int64{"ping": 1},
+			expectedLatency: []string{"ping"},
+		},
+		{
+			name:            "ping and canary",
+			canaryKey:       "otel:canary",
+			role:            "master",
+			expectedSuccess: map[string]int64{"ping": 1, "canary": 1},
+			expectedLatency: []string{"canary", "ping"},
+		},
+		{
+			name:            "failed probes",
+			canaryKey:       "otel:canary",
+			role:            "master",
+			pingErr:         errors.New("BUSY Redis is busy running a script."),
+			canaryErr:       errors.New("OOM command not allowed when used memory > 'maxmemory'."),
+			expectedSuccess: map[string]int64{"ping": 0, "canary": 0},
+		},
+		{
+			name:            "replica",
+			canaryKey:       "otel:canary",
+			role:            "slave",
+			expectedSuccess: map[string]int64{"ping": 1},
+			expectedLatency: []string{"ping"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Probe.Enabled = true
+			cfg.Probe.CanaryKey = test.canaryKey
+			client := &probeFakeClient{
+				replacingFakeClient: newReplacingFakeClient("role:master", "role:"+test.role),
+				pingErr:             test.pingErr,
+				canaryErr:           test.canaryErr,
+			}
+			runner, err := newRedisScraperWithClient(client, componenttest.NewNopReceiverCreateSettings(), cfg)
+			require.NoError(t, err)
+			md, err := runner.Scrape(context.Background())
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedSuccess, probeValues(md, "redis.probe.success"))
+			var latencyProbes []string
+			for probe := range probeValues(md, "redis.probe.latency") {
+				latencyProbes = append(latencyProbes, probe)
+			}
+			assert.ElementsMatch(t, test.expectedLatency, latencyProbes)
+			if _, ok := test.expectedSuccess["canary"]; ok {
+				assert.Equal(t, test.canaryKey, client.canaryKey)
+				assert.Equal(t, time.Minute, client.canaryTTL)
+			} else {
+				assert.Empty(t, client.canaryKey)
+			}
+		})
+	}
+}
+
+// infoFailingProbeClient fails INFO while answering the probes.
+type infoFailingProbeClient struct {
+	*probeFakeClient
+}
+
+func (c infoFailingProbeClient) retrieveInfo([]string) (string, error) {
+	return "", errors.New("ERR max number of clients reached")
+}
+
+func TestRedisScraperProbeInfoFailure(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Probe.Enabled = true
+	cfg.Probe.CanaryKey = "otel:canary"
+	client := infoFailingProbeClient{&probeFakeClient{replacingFakeClient: newReplacingFakeClient()}}
+	runner, err := newRedisScraperWithClient(client, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.Error(t, err)
+
+	// PING is still sent, but not the canary as the role is unknown
+	assert.Equal(t, map[string]int64{"ping": 1}, probeValues(md, "redis.probe.success"))
+	assert.Contains(t, probeValues(md, "redis.probe.latency"), "ping")
+	assert.Empty(t, client.canaryKey)
+}
+
+func TestRedisScraperWithoutProbe(t *testing.T) {
+	runner, err := newRedisScraperWithClient(newFakeClient(), componenttest.NewNopReceiverCreateSettings(), createDefaultConfig().(*Config))
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, probeValues(md, "redis.probe.success"))
+}
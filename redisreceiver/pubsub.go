@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"sort"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// Turns a PUBSUB SHARDCHANNELS reply into the channel names.
+func parseChannelsReply(val interface{}) ([]string, error) {
+	vals, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected channels reply '%v'", val)
+	}
+	channels := make([]string, 0, len(vals))
+	for _, v := range vals {
+		channel, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected channel '%v'", v)
+		}
+		channels = append(channels, channel)
+	}
+	return channels, nil
+}
+
+// Turns a PUBSUB SHARDNUMSUB reply, alternating channel names and subscriber
+// counts, into a map.
+func parseNumSubReply(val interface{}) (map[string]int64, error) {
+	vals, ok := val.([]interface{})
+	if !ok || len(vals)%2 != 0 {
+		return nil, fmt.Errorf("unexpected numsub reply '%v'", val)
+	}
+	subscribers := make(map[string]int64, len(vals)/2)
+	for i := 0; i < len(vals); i += 2 {
+		channel, channelOk := vals[i].(string)
+		count, countOk := vals[i+1].(int64)
+		if !channelOk || !countOk {
+			return nil, fmt.Errorf("unexpected numsub reply '%v'", val)
+		}
+		subscribers[channel] = count
+	}
+	return subscribers, nil
+}
+
+// recordPubSubMetrics records the number of subscribers of the configured
+// channels, and of the configured sharded channels on Redis 7 and later.
+// Channels given by name are always reported, with 0 subscribers once they
+// are all gone, while glob-style patterns are expanded into at most
+// max_channels of the channels that currently have subscribers.
+func (rs *redisScraper) recordPubSubMetrics(ts pdata.Timestamp, inf info) {
+	rs.recordChannelSubscribers(ts, rs.cfg.PubSub.Channels, false, rs.mb.RecordRedisPubsubChannelSubscribersDataPoint)
+	if len(rs.cfg.PubSub.ShardChannels) > 0 && redisMajorVersion(inf) >= 7 {
+		rs.recordChannelSubscribers(ts, rs.cfg.PubSub.ShardChannels, true, rs.mb.RecordRedisPubsubShardChannelSubscribersDataPoint)
+	}
+}
+
+func (rs *redisScraper) recordChannelSubscribers(ts pdata.Timestamp, configured []string, sharded bool,
+	record func(pdata.Timestamp, int64, string)) {
+	var channels []string
+	seen := map[string]bool{}
+	for _, channel := range configured {
+		matches := []string{channel}
+		if isGlobPattern(channel) {
+			var err error
+			matches, err = rs.redisSvc.client.retrieveChannels(channel, sharded)
+			if err != nil {
+				rs.errs.AddPartial(1, fmt.Errorf("failed to retrieve channels matching %s: %w", channel, err))
+				continue
+			}
+			sort.Strings(matches)
+			if len(matches) > rs.cfg.PubSub.MaxChannels {
+				matches = matches[:rs.cfg.PubSub.MaxChannels]
+			}
+		}
+		for _, match := range matches {
+			if !seen[match] {
+				seen[match] = true
+				channels = append(channels, match)
+			}
+		}
+	}
+	if len(channels) == 0 {
+		return
+	}
+
+	subscribers, err := rs.redisSvc.client.retrieveChannelSubscribers(channels, sharded)
+	if err != nil {
+		rs.errs.AddPartial(len(channels), fmt.Errorf("failed to retrieve channel subscribers: %w", err))
+		return
+	}
+	for _, channel := range channels {
+		record(ts, subscribers[channel], channel)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestParseChannelsReply(t *testing.T) {
+	channels, err := parseChannelsReply([]interface{}{"notify.email", "notify.sms"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"notify.email", "notify.sms"}, channels)
+
+	_, err = parseChannelsReply([]interface{}{int64(1)})
+	assert.Error(t, err)
+}
+
+func TestParseNumSubReply(t *testing.T) {
+	subscribers, err := parseNumSubReply([]interface{}{"notify.email", int64(2), "notify.sms", int64(0)})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"notify.email": 2, "notify.sms": 0}, subscribers)
+
+	_, err = parseNumSubReply([]interface{}{"notify.email"})
+	assert.Error(t, err)
+	_, err = parseNumSubReply([]interface{}{"notify.email", "2"})
+	assert.Error(t, err)
+}
+
+// pubsubFakeClient holds the subscribers of the channels and sharded channels.
+type pubsubFakeClient struct {
+	*replacingFakeClient
+	channels      map[string]int64
+	shardChannels map[string]int64
+}
+
+func (c *pubsubFakeClient) subscribers(sharded bool) map[string]int64 {
+	if sharded {
+		return c.shardChannels
+	}
+	return c.channels
+}
+
+func (c *pubsubFakeClient) retrieveChannels(pattern string, sharded bool) ([]string, error) {
+	expr, err := globToRegexp(pattern)
+	if err != nil {
+		return nil, err
+	}
+	re := regexp.MustCompile(expr)
+	var channels []string
+	for channel, count := range c.subscribers(sharded) {
+		if count > 0 && re.MatchString(channel) {
+			channels = append(channels, channel)
+		}
+	}
+	return channels, nil
+}
+
+func (c *pubsubFakeClient) retrieveChannelSubscribers(channels []string, sharded bool) (map[string]int64, error) {
+	subscribers := map[string]int64{}
+	for _, channel := range channels {
+		subscribers[channel] = c.subscribers(sharded)[channel]
+	}
+	return subscribers, nil
+}
+
+func channelSubscribers(md pdata.Metrics, name string) map[string]int64 {
+	m, ok := findMetric(md, name)
+	if !ok {
+		return nil
+	}
+	values := map[string]int64{}
+	dps := m.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		channel, _ := dps.At(i).Attributes().Get("channel")
+		values[channel.StringVal()] = dps.At(i).IntVal()
+	}
+	return values
+}
+
+func TestRedisScraperPubSub(t *testing.T) {
+	tests := []struct {
+		name          string
+		version       string
+		shardExpected map[string]int64
+	}{
+		{
+			name:    "redis 6",
+			version: "6.2.6",
+		},
+		{
+			name:          "redis 7",
+			version:       "7.0.5",
+			shardExpected: map[string]int64{"orders.eu": 1, "orders.us": 0},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.PubSub.Channels = []string{"notify.*", "notify.email", "audit"}
+			cfg.PubSub.ShardChannels = []string{"orders.*", "orders.us"}
+			cfg.PubSub.MaxChannels = 2
+			client := &pubsubFakeClient{
+				replacingFakeClient: newReplacingFakeClient("redis_version:5.0.7", "redis_version:"+test.version),
+				channels: map[string]int64{
+					"notify.email": 2,
+					"notify.push":  1,
+					"notify.sms":   3,
+					"audit":        0,
+				},
+				shardChannels: map[string]int64{"orders.eu": 1},
+			}
+			runner, err := newRedisScraperWithClient(client, componenttest.NewNopReceiverCreateSettings(), cfg)
+			require.NoError(t, err)
+			md, err := runner.Scrape(context.Background())
+			require.NoError(t, err)
+
+			// patterns are limited to max_channels, and channels given by name
+			// are reported even without subscribers
+			assert.Equal(t, map[string]int64{"notify.email": 2, "notify.push": 1, "audit": 0},
+				channelSubscribers(md, "redis.pubsub.channel.subscribers"))
+			assert.Equal(t, test.shardExpected, channelSubscribers(md, "redis.pubsub.shard_channel.subscribers"))
+		})
+	}
+}
+
+func TestRedisScraperWithoutPubSubChannels(t *testing.T) {
+	runner, err := newRedisScraperWithClient(newFakeClient(), componenttest.NewNopReceiverCreateSettings(), createDefaultConfig().(*Config))
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, channelSubscribers(md, "redis.pubsub.channel.subscribers"))
+	assert.NotNil(t, channelSubscribers(md, "redis.pubsub.channels"))
+}
@@ -16,14 +16,19 @@ package redisreceiver // import "github.com/open-telemetry/opentelemetry-collect
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v7"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
@@ -32,72 +37,261 @@ import (
 // Runs intermittently, fetching info from Redis, creating metrics/datapoints,
 // and feeding them to a metricsConsumer.
 type redisScraper struct {
-	redisSvc *redisSvc
-	settings component.ReceiverCreateSettings
-	mb       *metadata.MetricsBuilder
-	uptime   time.Duration
+	redisSvc      *redisSvc
+	endpoint      string
+	cfg           *Config
+	settings      component.ReceiverCreateSettings
+	mb            *metadata.MetricsBuilder
+	uptime        time.Duration
+	startTime     pdata.Timestamp
+	bigKeys       *bigKeySampler
+	keyPatterns   *keyPatternWalker
+	customMetrics []*customMetric
+	serverConfig  *serverConfigSnapshot
+	// Expansions of the watched glob-style patterns.
+	watchedKeyScans map[watchedPattern]*keyScan
+	// Streams discovered in each database.
+	streamScans map[int]*keyScan
+	// Identify the server when INFO fails, see recordResourceAttributes.
+	resourceOptions []metadata.ResourceOption
+	scrapeErrors    *scrapeErrorCounter
+	// Connects to the replicas of the server for the heartbeat, nil if they
+	// cannot be reached.
+	newReplicaClient clientFactory
+	replicaClients   map[string]client // keyed by replica address
+	// The times of the heartbeats written to the server, oldest first.
+	heartbeats []time.Time
+	// The failures of the current scrape that still let the other metrics
+	// be emitted.
+	errs scrapererror.ScrapeErrors
+	// Whether INFO succeeded on the last scrape.
+	available bool
 }
 
-const redisMaxDbs = 16 // Maximum possible number of redis databases
-
 func newRedisScraper(cfg *Config, settings component.ReceiverCreateSettings) (scraperhelper.Scraper, error) {
+	opts, err := newRedisOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	switch cfg.Mode {
+	case modeCluster:
+		return newClusterScraper(newRedisClient(opts), newClientFactory(opts), settings, cfg)
+	case modeSentinel:
+		return newSentinelScraper(newSentinelClients(cfg, opts), newClientFactory(opts), settings, cfg)
+	}
+
+	if len(cfg.Endpoints) > 0 {
+		addrs := make([]string, 0, len(cfg.Endpoints))
+		endpointOpts := make(map[string]*redis.Options, len(cfg.Endpoints))
+		for _, endpoint := range cfg.Endpoints {
+			if endpointOpts[endpoint.Endpoint], err = newEndpointOptions(cfg, endpoint); err != nil {
+				return nil, err
+			}
+			addrs = append(addrs, endpoint.Endpoint)
+		}
+		newClient := func(addr string) client {
+			if o, ok := endpointOpts[addr]; ok {
+				return newRedisClient(o)
+			}
+			// The replicas of the endpoints, read by the heartbeat.
+			return newClientFactory(opts)(addr)
+		}
+		return newEndpointsScraper(addrs, newClient, settings, cfg)
+	}
+	rs := newNodeScraper(newRedisClient(opts), cfg.Endpoint, settings, cfg)
+	rs.newReplicaClient = newClientFactory(opts)
+	return scraperhelper.NewScraper(typeStr, rs.Scrape, scraperhelper.WithShutdown(rs.shutdown))
+}
+
+// newSentinelClients creates a client for each configured sentinel, which
+// shares the TLS settings of the servers but has its own password.
+func newSentinelClients(cfg *Config, opts *redis.Options) []client {
+	sentinels := make([]client, 0, len(cfg.Sentinel.Addrs))
+	for _, addr := range cfg.Sentinel.Addrs {
+		sentinelOpts := *opts
+		sentinelOpts.Addr = addr
+		sentinelOpts.Username = ""
+		sentinelOpts.Password = cfg.Sentinel.Password
+		sentinelOpts.OnConnect = nil
+		sentinels = append(sentinels, newRedisClient(&sentinelOpts))
+	}
+	return sentinels
+}
+
+// newRedisOptions builds the connection options shared by every client created
+// for this receiver.
+func newRedisOptions(cfg *Config) (*redis.Options, error) {
+	return newEndpointOptions(cfg, EndpointSettings{NetAddr: confignet.NetAddr{Endpoint: cfg.Endpoint}})
+}
+
+// newEndpointOptions builds the connection options for one of the configured
+// endpoints, falling back to the receiver-level settings it does not override.
+func newEndpointOptions(cfg *Config, endpoint EndpointSettings) (*redis.Options, error) {
 	opts := &redis.Options{
-		Addr:     cfg.Endpoint,
+		Addr:     endpoint.Endpoint,
+		Username: cfg.Username,
 		Password: cfg.Password,
 		Network:  cfg.Transport,
 	}
+	if cfg.PasswordFile != "" {
+		opts.OnConnect = authenticateWithPasswordFile(cfg.Username, cfg.PasswordFile)
+	}
+	if endpoint.Password != "" {
+		opts.Password = endpoint.Password
+		opts.OnConnect = nil
+	}
+	if endpoint.Transport != "" {
+		opts.Network = endpoint.Transport
+	}
 
+	tls := cfg.TLS
+	if endpoint.TLS != nil {
+		tls = *endpoint.TLS
+	}
 	var err error
-	if opts.TLSConfig, err = cfg.TLS.LoadTLSConfig(); err != nil {
+	if opts.TLSConfig, err = tls.LoadTLSConfig(); err != nil {
 		return nil, err
 	}
-	return newRedisScraperWithClient(newRedisClient(opts), settings, cfg)
+	return opts, nil
 }
 
 func newRedisScraperWithClient(client client, settings component.ReceiverCreateSettings, cfg *Config) (scraperhelper.Scraper, error) {
-	rs := &redisScraper{
-		redisSvc: newRedisSvc(client),
+	rs := newNodeScraper(client, cfg.Endpoint, settings, cfg)
+	return scraperhelper.NewScraper(typeStr, rs.Scrape, scraperhelper.WithShutdown(rs.shutdown))
+}
+
+// newNodeScraper creates a redisScraper for the single Redis server at endpoint.
+func newNodeScraper(client client, endpoint string, settings component.ReceiverCreateSettings, cfg *Config) *redisScraper {
+	return &redisScraper{
+		redisSvc: newRedisSvc(client, infoSections(cfg)),
+		endpoint: endpoint,
+		cfg:      cfg,
 		settings: settings,
 		mb:       metadata.NewMetricsBuilder(cfg.Metrics),
+		// The endpoint is all that is known of a server never scraped.
+		resourceOptions: []metadata.ResourceOption{metadata.WithRedisEndpoint(endpoint)},
+		scrapeErrors:    newScrapeErrorCounter(cfg.Metrics, pdata.NewTimestampFromTime(time.Now())),
 	}
-	return scraperhelper.NewScraper(typeStr, rs.Scrape)
+}
+
+// shutdown closes the connections to the Redis server and its replicas.
+func (rs *redisScraper) shutdown(context.Context) error {
+	return multierr.Append(rs.redisSvc.client.close(), rs.closeReplicaClients())
 }
 
 // Scrape is called periodically, querying Redis and building Metrics to send to
 // the next consumer. First builds 'fixed' metrics (non-keyspace metrics)
 // defined at startup time. Then builds 'keyspace' metrics if there are any
 // keyspace lines returned by Redis. There should be one keyspace line per
-// active Redis database, of which there can be 16.
+// Redis database holding keys.
 func (rs *redisScraper) Scrape(context.Context) (pdata.Metrics, error) {
-	inf, err := rs.redisSvc.info()
-	if err != nil {
-		return pdata.Metrics{}, err
-	}
-
-	now := pdata.NewTimestampFromTime(time.Now())
-	currentUptime, err := inf.getUptimeInSeconds()
-	if err != nil {
-		return pdata.Metrics{}, err
-	}
-
-	if rs.uptime == time.Duration(0) || rs.uptime > currentUptime {
-		rs.mb.Reset(metadata.WithStartTime(pdata.NewTimestampFromTime(now.AsTime().Add(-currentUptime))))
-	}
-	rs.uptime = currentUptime
-
 	pdm := pdata.NewMetrics()
 	rm := pdm.ResourceMetrics().AppendEmpty()
 	ilm := rm.InstrumentationLibraryMetrics().AppendEmpty()
 	ilm.InstrumentationLibrary().SetName("otelcol/" + typeStr)
 
+	rs.errs = scrapererror.ScrapeErrors{}
+	inf, err := rs.scrapeInfo()
+	now := pdata.NewTimestampFromTime(time.Now())
+	rs.available = err == nil
+	if err != nil {
+		// Still report the server as down, identified by the resource
+		// attributes of the last successful scrape.
+		rs.scrapeErrors.counts[scrapeErrorCategory(err)]++
+		rs.mb.RecordRedisUpDataPoint(now, 0)
+		rs.recordProbeMetrics(now, nil)
+		rs.cfg.ResourceAttributes.SetResourceAttributes(rm.Resource(), rs.resourceOptions...)
+		rs.mb.Emit(ilm.Metrics())
+		rs.scrapeErrors.emit(now, ilm.Metrics())
+		return pdm, scrapererror.NewPartialScrapeError(err, 1)
+	}
+
+	rs.recordResourceAttributes(rm.Resource(), inf)
+	rs.mb.RecordRedisUpDataPoint(now, 1)
 	rs.recordCommonMetrics(now, inf)
 	rs.recordKeyspaceMetrics(now, inf)
 	rs.recordCommandStatsMetrics(now, inf)
 	rs.recordLatencyStatsMetrics(now, inf)
+	rs.recordErrorStatsMetrics(now, inf)
+	rs.recordReplicationMetrics(now, inf)
+	rs.recordAofSizeMetrics(now, inf)
+	rs.recordClientListMetrics(now)
+	rs.recordBigKeyMetrics(now, inf)
+	rs.recordKeyPatternMetrics(now, inf)
+	rs.recordWatchedKeyMetrics(now)
+	rs.recordStreamMetrics(now, inf)
+	rs.recordPubSubMetrics(now, inf)
+	rs.recordHeartbeatMetrics(now, inf)
+	rs.recordServerConfigMetrics(now)
+	rs.recordProbeMetrics(now, inf)
 
 	rs.mb.Emit(ilm.Metrics())
+	rs.removeUngroupedClientAttributes(ilm.Metrics())
+	rs.recordLatencyHistogramMetrics(now, inf, ilm.Metrics())
+	rs.recordCustomMetrics(now, inf, ilm.Metrics())
+	rs.scrapeErrors.emit(now, ilm.Metrics())
+
+	return pdm, rs.errs.Combine()
+}
 
-	return pdm, nil
+// scrapeInfo runs INFO and restarts the cumulative metrics when the server
+// restarted since the previous scrape. Sections that failed while the others
+// were returned are counted as partial errors.
+func (rs *redisScraper) scrapeInfo() (info, error) {
+	inf, err := rs.redisSvc.info()
+	var sectionsErr *infoSectionsError
+	if errors.As(err, &sectionsErr) {
+		rs.errs.AddPartial(len(sectionsErr.sections), err)
+	} else if err != nil {
+		if isAuthError(err) {
+			err = &authError{endpoint: rs.endpoint, err: err}
+			rs.settings.Logger.Error("Redis server rejected the credentials", zap.String("endpoint", rs.endpoint), zap.Error(err))
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	currentUptime, err := inf.getUptimeInSeconds()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInfoParse, err)
+	}
+
+	if rs.uptime == time.Duration(0) || rs.uptime > currentUptime {
+		rs.startTime = pdata.NewTimestampFromTime(now.Add(-currentUptime))
+		rs.mb.Reset(metadata.WithStartTime(rs.startTime))
+	}
+	rs.uptime = currentUptime
+	return inf, nil
+}
+
+// recordResourceAttributes sets the attributes identifying the server on the
+// resource, using the '# Server' and '# Replication' INFO sections. They are
+// kept for the scrapes failing afterwards.
+func (rs *redisScraper) recordResourceAttributes(r pdata.Resource, inf info) {
+	ro := []metadata.ResourceOption{metadata.WithRedisEndpoint(rs.endpoint)}
+	for infoKey, withAttr := range map[string]func(string) metadata.ResourceOption{
+		"redis_version":    metadata.WithRedisVersion,
+		"run_id":           metadata.WithRedisRunID,
+		"role":             metadata.WithRedisRole,
+		"redis_mode":       metadata.WithRedisMode,
+		"os":               metadata.WithRedisOs,
+		"maxmemory_policy": metadata.WithRedisMaxmemoryPolicy,
+	} {
+		if val, ok := inf[infoKey]; ok {
+			ro = append(ro, withAttr(val))
+		}
+	}
+	if val, ok := inf["tcp_port"]; ok {
+		port, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			rs.settings.Logger.Warn("failed to parse info int val", zap.String("key", "tcp_port"),
+				zap.String("val", val), zap.Error(err))
+		} else {
+			ro = append(ro, metadata.WithRedisTCPPort(port))
+		}
+	}
+	rs.resourceOptions = ro
+	rs.cfg.ResourceAttributes.SetResourceAttributes(r, ro...)
 }
 
 // recordCommonMetrics records metrics from Redis info key-value pairs.
@@ -113,39 +307,75 @@ func (rs *redisScraper) recordCommonMetrics(ts pdata.Timestamp, inf info) {
 		case func(pdata.Timestamp, int64):
 			val, err := strconv.ParseInt(infoVal, 10, 64)
 			if err != nil {
-				rs.settings.Logger.Warn("failed to parse info int val", zap.String("key", infoKey),
-					zap.String("val", infoVal), zap.Error(err))
+				rs.errs.AddPartial(1, fmt.Errorf("failed to parse info int val %s: %w", infoKey, err))
+				continue
 			}
 			recordDataPoint(ts, val)
 		case func(pdata.Timestamp, float64):
 			val, err := strconv.ParseFloat(infoVal, 64)
 			if err != nil {
-				rs.settings.Logger.Warn("failed to parse info float val", zap.String("key", infoKey),
-					zap.String("val", infoVal), zap.Error(err))
+				rs.errs.AddPartial(1, fmt.Errorf("failed to parse info float val %s: %w", infoKey, err))
+				continue
 			}
 			recordDataPoint(ts, val)
+		case func(pdata.Timestamp, string):
+			recordDataPoint(ts, infoVal)
 		}
 	}
+	rs.recordMemoryUtilization(ts, inf)
+}
+
+// recordMemoryUtilization records used_memory as a ratio of maxmemory, if a
+// limit is set.
+func (rs *redisScraper) recordMemoryUtilization(ts pdata.Timestamp, inf info) {
+	maxMemory, err := strconv.ParseInt(inf["maxmemory"], 10, 64)
+	if err != nil || maxMemory <= 0 {
+		return
+	}
+	usedMemory, err := strconv.ParseInt(inf["used_memory"], 10, 64)
+	if err != nil {
+		return
+	}
+	rs.mb.RecordRedisMemoryUtilizationDataPoint(ts, float64(usedMemory)/float64(maxMemory))
+}
+
+// recordAofSizeMetrics records the sizes of the AOF, e.g. "aof_current_size:1024",
+// which INFO only reports when AOF is enabled.
+func (rs *redisScraper) recordAofSizeMetrics(ts pdata.Timestamp, inf info) {
+	for infoKey, recordDataPoint := range map[string]func(pdata.Timestamp, int64){
+		"aof_current_size": rs.mb.RecordRedisAofCurrentSizeDataPoint,
+		"aof_base_size":    rs.mb.RecordRedisAofBaseSizeDataPoint,
+	} {
+		infoVal, ok := inf[infoKey]
+		if !ok {
+			continue
+		}
+		val, err := strconv.ParseInt(infoVal, 10, 64)
+		if err != nil {
+			rs.errs.AddPartial(1, fmt.Errorf("failed to parse info int val %s: %w", infoKey, err))
+			continue
+		}
+		recordDataPoint(ts, val)
+	}
 }
 
 // recordKeyspaceMetrics records metrics from 'keyspace' Redis info key-value pairs,
 // e.g. "db0: keys=1,expires=2,avg_ttl=3".
 func (rs *redisScraper) recordKeyspaceMetrics(ts pdata.Timestamp, inf info) {
-	for db := 0; db < redisMaxDbs; db++ {
+	for _, db := range inf.keyspaceDBs() {
 		key := "db" + strconv.Itoa(db)
-		str, ok := inf[key]
-		if !ok {
-			break
-		}
+		str := inf[key]
 		keyspace, parsingError := parseKeyspaceString(db, str)
 		if parsingError != nil {
-			rs.settings.Logger.Warn("failed to parse keyspace string", zap.String("key", key),
-				zap.String("val", str), zap.Error(parsingError))
+			rs.errs.AddPartial(1, fmt.Errorf("failed to parse keyspace string %s: %w", key, parsingError))
 			continue
 		}
 		rs.mb.RecordRedisDbKeysDataPoint(ts, int64(keyspace.keys), keyspace.db)
 		rs.mb.RecordRedisDbExpiresDataPoint(ts, int64(keyspace.expires), keyspace.db)
 		rs.mb.RecordRedisDbAvgTTLDataPoint(ts, int64(keyspace.avgTTL), keyspace.db)
+		if keyspace.hasSubexpiry {
+			rs.mb.RecordRedisDbSubexpiryDataPoint(ts, int64(keyspace.subexpiry), keyspace.db)
+		}
 	}
 }
 
@@ -156,8 +386,7 @@ func (rs *redisScraper) recordCommandStatsMetrics(ts pdata.Timestamp, inf info)
 		if strings.HasPrefix(infoKey, "cmdstat") {
 			commandstat, parsingError := parseCommandstatString(infoKey, infoVal)
 			if parsingError != nil {
-				rs.settings.Logger.Warn("failed to parse commandstat string", zap.String("key", infoKey),
-					zap.String("val", infoVal), zap.Error(parsingError))
+				rs.errs.AddPartial(1, fmt.Errorf("failed to parse commandstat string %s: %w", infoKey, parsingError))
 				continue
 			}
 			rs.mb.RecordRedisCommandCallsDataPoint(ts, int64(commandstat.calls), commandstat.command)
@@ -169,6 +398,23 @@ func (rs *redisScraper) recordCommandStatsMetrics(ts pdata.Timestamp, inf info)
 	}
 }
 
+// recordErrorStatsMetrics records metrics from 'errorstats' Redis info key-value pairs,
+// e.g. "errorstat_WRONGTYPE:count=2".
+func (rs *redisScraper) recordErrorStatsMetrics(ts pdata.Timestamp, inf info) {
+	keyPrefix := "errorstat_"
+	for infoKey, infoVal := range inf {
+		if !strings.HasPrefix(infoKey, keyPrefix) || len(infoKey) <= len(keyPrefix) {
+			continue
+		}
+		errorstat, parsingError := parseErrorstatString(infoKey[len(keyPrefix):], infoVal)
+		if parsingError != nil {
+			rs.errs.AddPartial(1, fmt.Errorf("failed to parse errorstat string %s: %w", infoKey, parsingError))
+			continue
+		}
+		rs.mb.RecordRedisErrorsDataPoint(ts, errorstat.count, errorstat.prefix)
+	}
+}
+
 // recordLatencyStatsMetrics records metrics from 'LatencyStatsMetrics' Redis info key-value pairs,
 // e.g. "latency_percentiles_usec_info:p50=10.123,p99=110.234,p99.9=120.234".
 func (rs *redisScraper) recordLatencyStatsMetrics(ts pdata.Timestamp, inf info) {
@@ -180,8 +426,7 @@ func (rs *redisScraper) recordLatencyStatsMetrics(ts pdata.Timestamp, inf info)
 		command := infoKey[len(keyPrefix):]
 		latencystats, parsingError := parseLatencystatsString(command, infoVal)
 		if parsingError != nil {
-			rs.settings.Logger.Warn("failed to parse latency stats string", zap.String("command", command),
-				zap.String("latencystats", infoVal), zap.Error(parsingError))
+			rs.errs.AddPartial(1, fmt.Errorf("failed to parse latency stats string %s: %w", infoKey, parsingError))
 			continue
 		}
 		for percentile, latency := range latencystats.stats {
@@ -16,6 +16,7 @@ package redisreceiver
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 
@@ -23,6 +24,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/config/configtls"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
@@ -40,9 +43,11 @@ func TestRedisRunnable(t *testing.T) {
 	require.NoError(t, err)
 	// + 16 because there are two keyspace entries each of which has three metrics and two commandstats entries each of which has five metrcis
 	// + 15 because there are five latency entries in ./testdata/info.txt and each of them has three different percentile stats.
+	// + 2 because there are two errorstats entries in ./testdata/info.txt.
+	// + 7 because of redis.up and the six categories of redis.scrape.error.
 	// rs.dataPointRecorders() is the number of pre-defined metrics in ./metric_functions.go
 	// md.DataPointCount() is the number of recorded data points
-	assert.Equal(t, len(rs.dataPointRecorders())+16+15, md.DataPointCount())
+	assert.Equal(t, len(rs.dataPointRecorders())+16+15+2+7, md.DataPointCount())
 	rm := md.ResourceMetrics().At(0)
 	ilm := rm.InstrumentationLibraryMetrics().At(0)
 	il := ilm.InstrumentationLibrary()
@@ -69,6 +74,109 @@ func TestRedisRunnable(t *testing.T) {
 	}
 }
 
+func TestRedisResourceAttributes(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "localhost:6379"
+	cfg.ResourceAttributes.RedisRunID.Enabled = true
+	cfg.ResourceAttributes.RedisOs.Enabled = false
+	runner, err := newRedisScraperWithClient(newFakeClient(), componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"redis.endpoint":         "localhost:6379",
+		"redis.version":          "5.0.7",
+		"redis.run_id":           "a3c8e3547fa3f13672342d4ce489e6061ff14c7d",
+		"redis.role":             "master",
+		"redis.mode":             "standalone",
+		"redis.tcp_port":         int64(6379),
+		"redis.maxmemory_policy": "noeviction",
+	}, md.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+}
+
+func TestRedisPersistenceMetrics(t *testing.T) {
+	client := newReplacingFakeClient(
+		"rdb_last_bgsave_status:ok", "rdb_last_bgsave_status:err",
+		"aof_enabled:0", "aof_enabled:1\naof_current_size:2048\naof_base_size:1024",
+	)
+	runner, err := newRedisScraperWithClient(client, componenttest.NewNopReceiverCreateSettings(), createDefaultConfig().(*Config))
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+
+	for name, expected := range map[string]int64{
+		"redis.rdb.last_bgsave.status":    0,
+		"redis.aof.last_write.status":     1,
+		"redis.aof.enabled":               1,
+		"redis.aof.current_size":          2048,
+		"redis.aof.base_size":             1024,
+		"redis.rdb.last_save.time":        1583427536,
+		"redis.rdb.last_bgsave.duration":  -1,
+		"redis.aof.last_bgrewrite.status": 1,
+	} {
+		m, ok := findMetric(md, name)
+		if assert.True(t, ok, name) {
+			assert.Equal(t, expected, m.Gauge().DataPoints().At(0).IntVal(), name)
+		}
+	}
+}
+
+func TestRedisMemoryUtilization(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	runner, err := newRedisScraperWithClient(newFakeClient(), componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	// no limit is set in testdata/info.txt
+	_, ok := findMetric(md, "redis.memory.utilization")
+	assert.False(t, ok)
+
+	runner, err = newRedisScraperWithClient(newReplacingFakeClient("maxmemory:0", "maxmemory:1708320"),
+		componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err = runner.Scrape(context.Background())
+	require.NoError(t, err)
+	m, ok := findMetric(md, "redis.memory.utilization")
+	require.True(t, ok)
+	assert.Equal(t, 0.5, m.Gauge().DataPoints().At(0).DoubleVal())
+}
+
+func TestRedisSparseKeyspace(t *testing.T) {
+	client := newReplacingFakeClient(
+		"db1:keys=4,expires=5,avg_ttl=6", "db5:keys=4,expires=5,avg_ttl=6,subexpiry=7\ndb20:keys=8,expires=0,avg_ttl=0",
+	)
+	runner, err := newRedisScraperWithClient(client, componenttest.NewNopReceiverCreateSettings(), createDefaultConfig().(*Config))
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+
+	dbValues := func(name string) map[string]int64 {
+		m, ok := findMetric(md, name)
+		require.True(t, ok, name)
+		values := map[string]int64{}
+		for i := 0; i < m.Gauge().DataPoints().Len(); i++ {
+			dp := m.Gauge().DataPoints().At(i)
+			db, _ := dp.Attributes().Get("db")
+			values[db.StringVal()] = dp.IntVal()
+		}
+		return values
+	}
+	// databases after a missing one, and beyond the default 16, are reported
+	assert.Equal(t, map[string]int64{"0": 1, "5": 4, "20": 8}, dbValues("redis.db.keys"))
+	// subexpiry is only reported by the servers that know of it
+	assert.Equal(t, map[string]int64{"5": 7}, dbValues("redis.db.subexpiry"))
+}
+
+// assertAttributesContain checks that the resource has at least the expected
+// attributes, ignoring those identifying the server.
+func assertAttributesContain(t *testing.T, expected map[string]interface{}, res pdata.Resource) {
+	actual := res.Attributes().AsRaw()
+	for k, v := range expected {
+		assert.Equal(t, v, actual[k], k)
+	}
+}
+
 type customFakeClient struct {
 	fakeClient
 }
@@ -78,8 +186,8 @@ func newCustomFakeClient() *customFakeClient {
 }
 
 // remove logs of latency stats from fakeClient and thus set empty latency stats
-func (c *customFakeClient) retrieveInfo() (string, error) {
-	str, err := c.fakeClient.retrieveInfo()
+func (c *customFakeClient) retrieveInfo([]string) (string, error) {
+	str, err := c.fakeClient.retrieveInfo(nil)
 	if err != nil {
 		return str, err
 	}
@@ -138,3 +246,66 @@ func TestNewReceiver_invalid_auth_error(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to load TLS config")
 	assert.Nil(t, r)
 }
+
+// sectionFailingClient returns testdata/info.txt as if some of its sections
+// had failed.
+type sectionFailingClient struct {
+	fakeClient
+	failed []string
+}
+
+func (c sectionFailingClient) retrieveInfo([]string) (string, error) {
+	str, err := c.fakeClient.retrieveInfo(nil)
+	if err != nil {
+		return "", err
+	}
+	return str, &infoSectionsError{sections: c.failed, err: errors.New("ERR blocked")}
+}
+
+func TestRedisPartialScrape(t *testing.T) {
+	tests := []struct {
+		name    string
+		client  client
+		failed  int
+		errMsg  string
+		missing string
+	}{
+		{
+			name:   "failed info sections",
+			client: sectionFailingClient{failed: []string{"latencystats", "errorstats"}},
+			failed: 2,
+			errMsg: "failed to retrieve INFO sections latencystats, errorstats: ERR blocked",
+		},
+		{
+			name:    "unparsable value",
+			client:  newReplacingFakeClient("used_memory:854160", "used_memory:n/a"),
+			failed:  1,
+			errMsg:  "failed to parse info int val used_memory",
+			missing: "redis.memory.used",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			runner, err := newRedisScraperWithClient(test.client, componenttest.NewNopReceiverCreateSettings(),
+				createDefaultConfig().(*Config))
+			require.NoError(t, err)
+			md, err := runner.Scrape(context.Background())
+			require.Error(t, err)
+			var partialErr scrapererror.PartialScrapeError
+			require.True(t, errors.As(err, &partialErr))
+			assert.Equal(t, test.failed, partialErr.Failed)
+			assert.Contains(t, err.Error(), test.errMsg)
+
+			// The other metrics are still emitted.
+			up, ok := findMetric(md, "redis.up")
+			require.True(t, ok)
+			assert.EqualValues(t, 1, up.Gauge().DataPoints().At(0).IntVal())
+			_, ok = findMetric(md, "redis.uptime")
+			assert.True(t, ok)
+			if test.missing != "" {
+				_, ok = findMetric(md, test.missing)
+				assert.False(t, ok)
+			}
+		})
+	}
+}
@@ -14,7 +14,10 @@
 
 package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
 
-import "strings"
+import (
+	"errors"
+	"strings"
+)
 
 // Wraps a client, parses the Redis info command, returning a string-string map
 // containing all of the key value pairs returned by INFO. Takes a line delimiter
@@ -23,20 +26,26 @@ import "strings"
 type redisSvc struct {
 	client    client
 	delimiter string
+	sections  []string
 }
 
-// Creates a new redisSvc. Pass in a client implementation.
-func newRedisSvc(client client) *redisSvc {
+// Creates a new redisSvc fetching the given INFO sections, or the default
+// ones if there are none. Pass in a client implementation.
+func newRedisSvc(client client, sections []string) *redisSvc {
 	return &redisSvc{
 		client:    client,
 		delimiter: client.delimiter(),
+		sections:  sections,
 	}
 }
 
-// Calls the Redis INFO command on the client and returns an `info` map.
+// Calls the Redis INFO command on the client and returns an `info` map. When
+// some sections failed, the others are returned along with an
+// *infoSectionsError.
 func (p *redisSvc) info() (info, error) {
-	str, err := p.client.retrieveInfo()
-	if err != nil {
+	str, err := p.client.retrieveInfo(p.sections)
+	var sectionsErr *infoSectionsError
+	if err != nil && !errors.As(err, &sectionsErr) {
 		return nil, err
 	}
 
@@ -51,5 +60,5 @@ func (p *redisSvc) info() (info, error) {
 			attrs[pair[0]] = pair[1]
 		}
 	}
-	return attrs, nil
+	return attrs, err
 }
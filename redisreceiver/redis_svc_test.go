@@ -15,21 +15,34 @@
 package redisreceiver
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func newFakeAPIParser() *redisSvc {
-	return newRedisSvc(fakeClient{})
+	return newRedisSvc(fakeClient{}, nil)
 }
 
 func TestParser(t *testing.T) {
 	s := newFakeAPIParser()
 	info, err := s.info()
 	require.Nil(t, err)
-	require.Equal(t, 125+5, len(info))                                                                         // with 5 additional latencyStats lines
+	require.Equal(t, 125+5+3, len(info))                                                                       // with 5 additional latencyStats lines and 3 errorstats lines
 	require.Equal(t, "1.24", info["allocator_frag_ratio"])                                                     // spot check
 	require.Equal(t, "calls=2,usec=4,usec_per_call=2.00,rejected_calls=0,failed_calls=0", info["cmdstat_get"]) // check commandstats
 	require.Equal(t, "p50=10.123,p99=110.234,p99.9=120.234", info["latency_percentiles_usec_info"])
+	require.Equal(t, "count=2", info["errorstat_WRONGTYPE"]) // check errorstats
+}
+
+func TestParserFailedSections(t *testing.T) {
+	s := newRedisSvc(sectionFailingClient{failed: []string{"latencystats"}}, nil)
+	info, err := s.info()
+	var sectionsErr *infoSectionsError
+	require.True(t, errors.As(err, &sectionsErr))
+	assert.Equal(t, []string{"latencystats"}, sectionsErr.sections)
+	// the sections that succeeded are still parsed
+	assert.Equal(t, "1.24", info["allocator_frag_ratio"])
 }
@@ -0,0 +1,115 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// Holds a replica line of the replication section of the INFO command: e.g.
+// "slave0:ip=10.0.0.2,port=6379,state=online,offset=3290,lag=1"
+type replica struct {
+	addr   string
+	state  string
+	offset int64
+	lag    int64
+}
+
+// Turns a replica value (the part after the colon
+// e.g. "ip=10.0.0.2,port=6379,state=online,offset=3290,lag=1") into a replica struct
+func parseReplicaString(str string) (*replica, error) {
+	var r replica
+	var ip, port string
+	for _, pairStr := range strings.Split(str, ",") {
+		pair := strings.Split(pairStr, "=")
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("unexpected replica pair '%s'", pairStr)
+		}
+		var err error
+		switch pair[0] {
+		case "ip":
+			ip = pair[1]
+		case "port":
+			port = pair[1]
+		case "state":
+			r.state = pair[1]
+		case "offset":
+			r.offset, err = strconv.ParseInt(pair[1], 10, 64)
+		case "lag":
+			r.lag, err = strconv.ParseInt(pair[1], 10, 64)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if ip == "" || port == "" {
+		return nil, fmt.Errorf("replica '%s' has no address", str)
+	}
+	r.addr = net.JoinHostPort(ip, port)
+	return &r, nil
+}
+
+// recordReplicationMetrics records the state of each replica on a primary,
+// e.g. "slave0:ip=10.0.0.2,port=6379,state=online,offset=3290,lag=1", and the
+// state of the link to the primary on a replica, e.g. "master_link_status:up".
+func (rs *redisScraper) recordReplicationMetrics(ts pdata.Timestamp, inf info) {
+	masterOffset, masterOffsetErr := strconv.ParseInt(inf["master_repl_offset"], 10, 64)
+	for i := 0; ; i++ {
+		key := "slave" + strconv.Itoa(i)
+		str, ok := inf[key]
+		if !ok {
+			break
+		}
+		r, err := parseReplicaString(str)
+		if err != nil {
+			rs.errs.AddPartial(1, fmt.Errorf("failed to parse replica string %s: %w", key, err))
+			continue
+		}
+		if masterOffsetErr == nil {
+			rs.mb.RecordRedisReplicationReplicaOffsetDeltaDataPoint(ts, masterOffset-r.offset, r.addr)
+		}
+		rs.mb.RecordRedisReplicationReplicaLagDataPoint(ts, r.lag, r.addr)
+		rs.mb.RecordRedisReplicationReplicaStateDataPoint(ts, 1, r.addr, r.state)
+	}
+
+	if status, ok := inf["master_link_status"]; ok {
+		var up int64
+		if status == "up" {
+			up = 1
+		}
+		rs.mb.RecordRedisReplicationMasterLinkUpDataPoint(ts, up)
+	}
+	for infoKey, recordDataPoint := range map[string]func(pdata.Timestamp, int64){
+		"master_last_io_seconds_ago":     rs.mb.RecordRedisReplicationMasterLinkLastIoDataPoint,
+		"master_sync_in_progress":        rs.mb.RecordRedisReplicationMasterLinkSyncInProgressDataPoint,
+		"master_link_down_since_seconds": rs.mb.RecordRedisReplicationMasterLinkDownSinceDataPoint,
+	} {
+		infoVal, ok := inf[infoKey]
+		if !ok {
+			continue
+		}
+		val, err := strconv.ParseInt(infoVal, 10, 64)
+		if err != nil {
+			rs.errs.AddPartial(1, fmt.Errorf("failed to parse info int val %s: %w", infoKey, err))
+			continue
+		}
+		recordDataPoint(ts, val)
+	}
+}
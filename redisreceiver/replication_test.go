@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestParseReplicaString(t *testing.T) {
+	r, err := parseReplicaString("ip=10.0.0.2,port=6379,state=online,offset=3290,lag=1")
+	require.NoError(t, err)
+	assert.Equal(t, &replica{addr: "10.0.0.2:6379", state: "online", offset: 3290, lag: 1}, r)
+
+	r, err = parseReplicaString("ip=::1,port=6380,state=wait_bgsave,offset=0,lag=0")
+	require.NoError(t, err)
+	assert.Equal(t, "[::1]:6380", r.addr)
+
+	_, err = parseReplicaString("ip=10.0.0.2,port=6379,state=online,offset=x,lag=1")
+	require.Error(t, err)
+	_, err = parseReplicaString("state=online,offset=0,lag=1")
+	require.Error(t, err)
+}
+
+func TestRedisScraperReplicationMetrics(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     string
+		expected map[string][]map[string]interface{}
+	}{
+		{
+			name: "primary",
+			info: "role:master\nconnected_slaves:2\n" +
+				"slave0:ip=10.0.0.2,port=6379,state=online,offset=0,lag=0\n" +
+				"slave1:ip=10.0.0.3,port=6379,state=send_bulk,offset=0,lag=12\n",
+			expected: map[string][]map[string]interface{}{
+				"redis.replication.replica.offset_delta": {{"replica": "10.0.0.2:6379"}, {"replica": "10.0.0.3:6379"}},
+				"redis.replication.replica.lag":          {{"replica": "10.0.0.2:6379"}, {"replica": "10.0.0.3:6379"}},
+				"redis.replication.replica.state": {
+					{"replica": "10.0.0.2:6379", "state": "online"},
+					{"replica": "10.0.0.3:6379", "state": "send_bulk"},
+				},
+			},
+		},
+		{
+			name: "replica",
+			info: "role:slave\nmaster_host:10.0.0.1\nmaster_port:6379\nmaster_link_status:down\n" +
+				"master_last_io_seconds_ago:-1\nmaster_sync_in_progress:0\nmaster_link_down_since_seconds:42\n" +
+				"connected_slaves:0\n",
+			expected: map[string][]map[string]interface{}{
+				"redis.replication.master_link.up":               {{}},
+				"redis.replication.master_link.last_io":          {{}},
+				"redis.replication.master_link.sync_in_progress": {{}},
+				"redis.replication.master_link.down_since":       {{}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			client := newReplacingFakeClient("role:master\nconnected_slaves:0\n", test.info)
+			scraper, err := newRedisScraperWithClient(client, componenttest.NewNopReceiverCreateSettings(), cfg)
+			require.NoError(t, err)
+			md, err := scraper.Scrape(context.Background())
+			require.NoError(t, err)
+
+			for _, name := range []string{
+				"redis.replication.replica.offset_delta",
+				"redis.replication.replica.lag",
+				"redis.replication.replica.state",
+				"redis.replication.master_link.up",
+				"redis.replication.master_link.last_io",
+				"redis.replication.master_link.sync_in_progress",
+				"redis.replication.master_link.down_since",
+			} {
+				m, ok := findMetric(md, name)
+				expected, want := test.expected[name]
+				require.Equal(t, want, ok, name)
+				if !ok {
+					continue
+				}
+				dps := m.Gauge().DataPoints()
+				dps.Sort(func(a, b pdata.NumberDataPoint) bool {
+					aReplica, _ := a.Attributes().Get("replica")
+					bReplica, _ := b.Attributes().Get("replica")
+					return aReplica.StringVal() < bReplica.StringVal()
+				})
+				require.Equal(t, len(expected), dps.Len(), name)
+				for i, attrs := range expected {
+					assert.Equal(t, attrs, dps.At(i).Attributes().AsRaw(), name)
+				}
+			}
+
+			if test.name == "replica" {
+				m, _ := findMetric(md, "redis.replication.master_link.down_since")
+				assert.Equal(t, int64(42), m.Gauge().DataPoints().At(0).IntVal())
+				m, _ = findMetric(md, "redis.replication.master_link.up")
+				assert.Equal(t, int64(0), m.Gauge().DataPoints().At(0).IntVal())
+			} else {
+				m, _ := findMetric(md, "redis.replication.replica.lag")
+				assert.Equal(t, int64(12), m.Gauge().DataPoints().At(1).IntVal())
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+)
+
+// Turns a SENTINEL MASTERS or SENTINEL SLAVES reply, a list of flat
+// field/value lists, into one map per server.
+func parseSentinelReply(vals []interface{}) ([]map[string]string, error) {
+	entries := make([]map[string]string, 0, len(vals))
+	for _, val := range vals {
+		fields, ok := val.([]interface{})
+		if !ok || len(fields)%2 != 0 {
+			return nil, fmt.Errorf("unexpected sentinel entry '%v'", val)
+		}
+		entry := make(map[string]string, len(fields)/2)
+		for i := 0; i < len(fields); i += 2 {
+			key, keyOk := fields[i].(string)
+			value, valueOk := fields[i+1].(string)
+			if !keyOk || !valueOk {
+				return nil, fmt.Errorf("unexpected sentinel field '%v=%v'", fields[i], fields[i+1])
+			}
+			entry[key] = value
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Finds the current primaries and replicas of the configured masters by
+// asking each sentinel in turn until one answers.
+type sentinelDiscoverer struct {
+	sentinels   []client
+	masterNames []string
+}
+
+func newSentinelScraper(sentinels []client, newClient clientFactory, settings component.ReceiverCreateSettings, cfg *Config) (scraperhelper.Scraper, error) {
+	d := &sentinelDiscoverer{sentinels: sentinels, masterNames: cfg.Sentinel.MasterNames}
+	return newDiscoveryScraper(d, cfg.Sentinel.RefreshInterval, newClient, settings, cfg)
+}
+
+func (d *sentinelDiscoverer) discover(map[string]client) ([]*redisNode, error) {
+	var errs error
+	for _, sentinel := range d.sentinels {
+		nodes, err := d.discoverFrom(sentinel)
+		if err == nil {
+			return nodes, nil
+		}
+		errs = multierr.Append(errs, err)
+	}
+	return nil, fmt.Errorf("failed to discover nodes from sentinels: %w", errs)
+}
+
+// discoverFrom returns the primary and healthy replicas of every configured
+// master known to the sentinel.
+func (d *sentinelDiscoverer) discoverFrom(sentinel client) ([]*redisNode, error) {
+	masters, err := sentinel.retrieveSentinelMasters()
+	if err != nil {
+		return nil, err
+	}
+
+	missing := make(map[string]bool, len(d.masterNames))
+	for _, name := range d.masterNames {
+		missing[name] = true
+	}
+
+	var nodes []*redisNode
+	for _, master := range masters {
+		name := master["name"]
+		if len(d.masterNames) > 0 && !missing[name] {
+			continue
+		}
+		delete(missing, name)
+
+		if !isSentinelNodeDown(master["flags"]) {
+			nodes = append(nodes, newSentinelNode(name, "master", master))
+		}
+		replicas, err := sentinel.retrieveSentinelReplicas(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, replica := range replicas {
+			if !isSentinelNodeDown(replica["flags"]) {
+				nodes = append(nodes, newSentinelNode(name, "slave", replica))
+			}
+		}
+	}
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("masters %q are not monitored by the sentinel", names)
+	}
+	return nodes, nil
+}
+
+func (d *sentinelDiscoverer) close() error {
+	var errs error
+	for _, sentinel := range d.sentinels {
+		errs = multierr.Append(errs, sentinel.close())
+	}
+	return errs
+}
+
+// newSentinelNode returns the server described by a sentinel entry, tagged
+// with the master it belongs to and its current role.
+func newSentinelNode(masterName string, role string, entry map[string]string) *redisNode {
+	addr := net.JoinHostPort(entry["ip"], entry["port"])
+	return &redisNode{
+		addr: addr,
+		resourceOptions: []metadata.ResourceOption{
+			metadata.WithRedisSentinelMasterName(masterName),
+			metadata.WithRedisSentinelNodeAddress(addr),
+			metadata.WithRedisSentinelNodeRole(role),
+		},
+	}
+}
+
+// isSentinelNodeDown reports whether the sentinel flags mark a server as
+// unreachable, e.g. "master,s_down,o_down" or "slave,disconnected".
+func isSentinelNodeDown(flags string) bool {
+	for _, flag := range strings.Split(flags, ",") {
+		switch flag {
+		case "s_down", "o_down", "disconnected":
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+// fakeSentinelClient serves configurable SENTINEL MASTERS and SENTINEL SLAVES
+// replies and records whether it was closed.
+type fakeSentinelClient struct {
+	fakeClient
+	masters  []map[string]string
+	replicas map[string][]map[string]string
+	err      error
+	closed   bool
+}
+
+func (c *fakeSentinelClient) retrieveSentinelMasters() ([]map[string]string, error) {
+	return c.masters, c.err
+}
+
+func (c *fakeSentinelClient) retrieveSentinelReplicas(name string) ([]map[string]string, error) {
+	return c.replicas[name], c.err
+}
+
+func (c *fakeSentinelClient) close() error {
+	c.closed = true
+	return nil
+}
+
+func TestParseSentinelReply(t *testing.T) {
+	entries, err := parseSentinelReply([]interface{}{
+		[]interface{}{"name", "mymaster", "ip", "10.0.0.1", "port", "6379", "flags", "master"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []map[string]string{
+		{"name": "mymaster", "ip": "10.0.0.1", "port": "6379", "flags": "master"},
+	}, entries)
+
+	_, err = parseSentinelReply([]interface{}{[]interface{}{"name"}})
+	require.Error(t, err)
+}
+
+func TestSentinelScraperFollowsFailover(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Mode = modeSentinel
+	cfg.Sentinel.MasterNames = []string{"mymaster"}
+	cfg.Sentinel.RefreshInterval = time.Nanosecond
+
+	down := &fakeSentinelClient{err: errors.New("connection refused")}
+	sentinel := &fakeSentinelClient{
+		masters: []map[string]string{
+			{"name": "mymaster", "ip": "10.0.0.1", "port": "6379", "flags": "master"},
+			{"name": "other", "ip": "10.0.1.1", "port": "6379", "flags": "master"},
+		},
+		replicas: map[string][]map[string]string{
+			"mymaster": {
+				{"name": "10.0.0.2:6379", "ip": "10.0.0.2", "port": "6379", "flags": "slave"},
+				{"name": "10.0.0.3:6379", "ip": "10.0.0.3", "port": "6379", "flags": "slave,s_down,disconnected"},
+			},
+		},
+	}
+	clients := map[string]*fakeClusterClient{}
+	factory := func(addr string) client {
+		clients[addr] = &fakeClusterClient{}
+		return clients[addr]
+	}
+	scraper, err := newSentinelScraper([]client{down, sentinel}, factory, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	md, err := scraper.Scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, md.ResourceMetrics().Len())
+	assertAttributesContain(t, map[string]interface{}{
+		"redis.sentinel.master_name":  "mymaster",
+		"redis.sentinel.node.address": "10.0.0.1:6379",
+		"redis.sentinel.node.role":    "master",
+	}, md.ResourceMetrics().At(0).Resource())
+	assert.Equal(t, "slave", md.ResourceMetrics().At(1).Resource().Attributes().AsRaw()["redis.sentinel.node.role"])
+
+	// the replica is promoted and the old primary is gone
+	sentinel.masters[0] = map[string]string{"name": "mymaster", "ip": "10.0.0.2", "port": "6379", "flags": "master"}
+	sentinel.replicas["mymaster"] = nil
+	md, err = scraper.Scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	assertAttributesContain(t, map[string]interface{}{
+		"redis.sentinel.master_name":  "mymaster",
+		"redis.sentinel.node.address": "10.0.0.2:6379",
+		"redis.sentinel.node.role":    "master",
+	}, md.ResourceMetrics().At(0).Resource())
+	assert.True(t, clients["10.0.0.1:6379"].closed)
+	assert.False(t, clients["10.0.0.2:6379"].closed)
+
+	require.NoError(t, scraper.Shutdown(context.Background()))
+	assert.True(t, down.closed)
+	assert.True(t, sentinel.closed)
+}
+
+func TestSentinelScraperUnknownMaster(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Mode = modeSentinel
+	cfg.Sentinel.MasterNames = []string{"missing"}
+	sentinel := &fakeSentinelClient{
+		masters: []map[string]string{{"name": "mymaster", "ip": "10.0.0.1", "port": "6379", "flags": "master"}},
+	}
+	scraper, err := newSentinelScraper([]client{sentinel}, func(string) client { return newFakeClient() }, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	_, err = scraper.Scrape(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `masters ["missing"] are not monitored`)
+}
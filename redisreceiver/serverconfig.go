@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+// The values of the server configuration parameters, as of the last CONFIG
+// GET.
+type serverConfigSnapshot struct {
+	values    map[string]string
+	refreshed time.Time
+}
+
+// recordServerConfigMetrics refreshes the server configuration every
+// refresh_interval if enabled, and records its numeric parameters as
+// redis.config.value and the others as redis.config.info. The previous values
+// are kept if the refresh fails, e.g. as CONFIG is disabled by managed
+// services.
+func (rs *redisScraper) recordServerConfigMetrics(ts pdata.Timestamp) {
+	settings := rs.cfg.ServerConfig
+	if !settings.Enabled {
+		return
+	}
+	now := ts.AsTime()
+	if rs.serverConfig == nil || now.Sub(rs.serverConfig.refreshed) >= settings.RefreshInterval {
+		values, err := rs.redisSvc.client.retrieveConfig(settings.Parameters)
+		switch {
+		case err != nil && rs.serverConfig == nil:
+			rs.errs.AddPartial(1, fmt.Errorf("failed to retrieve server config: %w", err))
+		case err != nil:
+			// Nothing is lost as long as the previous values are reported.
+			rs.settings.Logger.Warn("failed to refresh server config, reporting the previous values", zap.Error(err))
+		default:
+			rs.serverConfig = &serverConfigSnapshot{values: values, refreshed: now}
+		}
+	}
+	if rs.serverConfig == nil {
+		return
+	}
+
+	parameters := make([]string, 0, len(rs.serverConfig.values))
+	for parameter := range rs.serverConfig.values {
+		parameters = append(parameters, parameter)
+	}
+	sort.Strings(parameters)
+	for _, parameter := range parameters {
+		val := rs.serverConfig.values[parameter]
+		if num, err := strconv.ParseFloat(val, 64); err == nil {
+			rs.mb.RecordRedisConfigValueDataPoint(ts, num, parameter)
+		} else {
+			rs.mb.RecordRedisConfigInfoDataPoint(ts, 1, parameter, val)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+)
+
+// configFakeClient serves a fixed server configuration, counting the calls.
+type configFakeClient struct {
+	fakeClient
+	values     map[string]string
+	err        error
+	parameters []string
+	calls      int
+}
+
+func (c *configFakeClient) retrieveConfig(parameters []string) (map[string]string, error) {
+	c.calls++
+	c.parameters = parameters
+	return c.values, c.err
+}
+
+func configValues(md pdata.Metrics) (map[string]float64, map[string]string) {
+	numeric := map[string]float64{}
+	if m, ok := findMetric(md, "redis.config.value"); ok {
+		for i := 0; i < m.Gauge().DataPoints().Len(); i++ {
+			dp := m.Gauge().DataPoints().At(i)
+			parameter, _ := dp.Attributes().Get("parameter")
+			numeric[parameter.StringVal()] = dp.DoubleVal()
+		}
+	}
+	other := map[string]string{}
+	if m, ok := findMetric(md, "redis.config.info"); ok {
+		for i := 0; i < m.Gauge().DataPoints().Len(); i++ {
+			dp := m.Gauge().DataPoints().At(i)
+			parameter, _ := dp.Attributes().Get("parameter")
+			value, _ := dp.Attributes().Get("value")
+			other[parameter.StringVal()] = value.StringVal()
+		}
+	}
+	return numeric, other
+}
+
+func TestRedisScraperServerConfig(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ServerConfig.Enabled = true
+	client := &configFakeClient{values: map[string]string{
+		"maxclients": "10000",
+		"maxmemory":  "0",
+		"appendonly": "no",
+		"save":       "3600 1 300 100 60 10000",
+	}}
+	rs := newNodeScraper(client, "localhost:6379", componenttest.NewNopReceiverCreateSettings(), cfg)
+
+	md, err := rs.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, cfg.ServerConfig.Parameters, client.parameters)
+	numeric, other := configValues(md)
+	assert.Equal(t, map[string]float64{"maxclients": 10000, "maxmemory": 0}, numeric)
+	assert.Equal(t, map[string]string{"appendonly": "no", "save": "3600 1 300 100 60 10000"}, other)
+
+	// the configuration is only refreshed every refresh_interval
+	client.values = map[string]string{"maxclients": "20000"}
+	md, err = rs.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.calls)
+	numeric, _ = configValues(md)
+	assert.Equal(t, float64(10000), numeric["maxclients"])
+
+	rs.serverConfig.refreshed = rs.serverConfig.refreshed.Add(-cfg.ServerConfig.RefreshInterval)
+	md, err = rs.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, client.calls)
+	numeric, other = configValues(md)
+	assert.Equal(t, map[string]float64{"maxclients": 20000}, numeric)
+	assert.Empty(t, other)
+}
+
+func TestRedisScraperServerConfigError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.ServerConfig.Enabled = true
+	cfg.ServerConfig.RefreshInterval = time.Nanosecond
+	client := &configFakeClient{err: errors.New("ERR unknown command 'CONFIG'")}
+	rs := newNodeScraper(client, "localhost:6379", componenttest.NewNopReceiverCreateSettings(), cfg)
+
+	md, err := rs.Scrape(context.Background())
+	require.Error(t, err)
+	assert.True(t, scrapererror.IsPartialScrapeError(err))
+	numeric, other := configValues(md)
+	assert.Empty(t, numeric)
+	assert.Empty(t, other)
+
+	// the previous values are kept when a refresh fails
+	client.values, client.err = map[string]string{"hz": "10"}, nil
+	_, err = rs.Scrape(context.Background())
+	require.NoError(t, err)
+	client.values, client.err = nil, errors.New("i/o timeout")
+	md, err = rs.Scrape(context.Background())
+	require.NoError(t, err)
+	numeric, _ = configValues(md)
+	assert.Equal(t, map[string]float64{"hz": 10}, numeric)
+}
+
+func TestRedisScraperWithoutServerConfig(t *testing.T) {
+	client := &configFakeClient{values: map[string]string{"hz": "10"}}
+	rs := newNodeScraper(client, "localhost:6379", componenttest.NewNopReceiverCreateSettings(), createDefaultConfig().(*Config))
+	_, err := rs.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, client.calls)
+}
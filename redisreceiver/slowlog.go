@@ -0,0 +1,419 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver/internal/metadata"
+)
+
+// Attributes of the log records emitted for SLOWLOG entries.
+const (
+	slowLogIDAttr         = "redis.slowlog.id"
+	slowLogDurationAttr   = "redis.slowlog.duration_us"
+	slowLogCommandAttr    = "redis.command"
+	slowLogArgsAttr       = "redis.command.args"
+	slowLogClientAddrAttr = "redis.client.address"
+	slowLogClientNameAttr = "redis.client.name"
+)
+
+// Holds an entry of the SLOWLOG GET reply: e.g.
+// 1) (integer) 14
+// 2) (integer) 1309448221
+// 3) (integer) 15
+// 4) 1) "ping"
+// 5) "127.0.0.1:58217"
+// 6) "worker-1"
+type slowLogEntry struct {
+	id         int64
+	timestamp  time.Time
+	duration   time.Duration
+	args       []string
+	clientAddr string // only reported by Redis 4.0 and later
+	clientName string // only reported by Redis 4.0 and later
+}
+
+// Turns a SLOWLOG GET reply into its entries, keeping the reply order.
+func parseSlowLog(vals []interface{}) ([]*slowLogEntry, error) {
+	entries := make([]*slowLogEntry, 0, len(vals))
+	for _, val := range vals {
+		fields, ok := val.([]interface{})
+		if !ok || len(fields) < 4 {
+			return nil, fmt.Errorf("unexpected slowlog entry '%v'", val)
+		}
+		id, idOk := fields[0].(int64)
+		timestamp, timestampOk := fields[1].(int64)
+		duration, durationOk := fields[2].(int64)
+		args, argsOk := fields[3].([]interface{})
+		if !idOk || !timestampOk || !durationOk || !argsOk {
+			return nil, fmt.Errorf("unexpected slowlog entry '%v'", val)
+		}
+
+		entry := &slowLogEntry{
+			id:        id,
+			timestamp: time.Unix(timestamp, 0),
+			duration:  time.Duration(duration) * time.Microsecond,
+			args:      make([]string, 0, len(args)),
+		}
+		for _, arg := range args {
+			str, ok := arg.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected slowlog argument '%v'", arg)
+			}
+			entry.args = append(entry.args, str)
+		}
+		if len(fields) >= 6 {
+			entry.clientAddr, _ = fields[4].(string)
+			entry.clientName, _ = fields[5].(string)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// redactArgs replaces every argument after the command name with "?". The
+// marker Redis uses for arguments it dropped, e.g. "... (2 more arguments)",
+// carries no data and is kept.
+func redactArgs(args []string) []string {
+	redacted := make([]string, 0, len(args))
+	for i, arg := range args {
+		if i > 0 && !(strings.HasPrefix(arg, "... (") && strings.HasSuffix(arg, " more arguments)")) {
+			arg = "?"
+		}
+		redacted = append(redacted, arg)
+	}
+	return redacted
+}
+
+// Reads the SLOWLOG of a single server, remembering the newest entry seen so
+// that entries are only returned once.
+type slowLogPoller struct {
+	endpoint string
+	client   client
+	server   *redisSvc // reads the run_id
+	lastID   int64
+	// the run_id of the server when lastID was seen
+	runID string
+	// set once the entries retained before the first poll were seen
+	seeded bool
+	// the resource attributes identifying the server
+	resourceOptions []metadata.ResourceOption
+}
+
+// newSlowLogPoller creates a poller that skips the entries the server retained
+// before the first poll, unless emitBacklog is set, so that restarting the
+// collector does not emit them again.
+func newSlowLogPoller(endpoint string, client client, emitBacklog bool) *slowLogPoller {
+	return &slowLogPoller{
+		endpoint:        endpoint,
+		client:          client,
+		server:          newRedisSvc(client, []string{"server"}),
+		lastID:          -1,
+		seeded:          emitBacklog,
+		resourceOptions: []metadata.ResourceOption{metadata.WithRedisEndpoint(endpoint)},
+	}
+}
+
+// poll returns the entries added since the previous poll, oldest first.
+func (p *slowLogPoller) poll(maxEntries int64) ([]*slowLogEntry, error) {
+	// The SLOWLOG and its entry ids start over when the server restarts,
+	// which changes its run_id.
+	inf, err := p.server.info()
+	if err != nil {
+		return nil, err
+	}
+	if runID := inf["run_id"]; runID != p.runID {
+		if p.runID != "" {
+			p.lastID = -1
+		}
+		p.runID = runID
+	}
+
+	entries, err := p.client.retrieveSlowLog(maxEntries)
+	if err != nil {
+		return nil, err
+	}
+	if !p.seeded {
+		p.seeded = true
+		if len(entries) > 0 {
+			p.lastID = entries[0].id
+		}
+		return nil, nil
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	newest := entries[0].id
+	var fresh []*slowLogEntry
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].id > p.lastID {
+			fresh = append(fresh, entries[i])
+		}
+	}
+	p.lastID = newest
+	return fresh, nil
+}
+
+// Polls SLOWLOG on every collection interval and emits each new entry as a
+// log record.
+type slowLogReceiver struct {
+	cfg      *Config
+	settings component.ReceiverCreateSettings
+	consumer consumer.Logs
+	pollers  []*slowLogPoller // sorted by endpoint
+	cancel   context.CancelFunc
+	done     chan struct{}
+
+	// Finds the servers to poll in cluster and sentinel modes, nil otherwise.
+	discoverer      discoverer
+	refreshInterval time.Duration
+	newClient       clientFactory
+	lastRefresh     time.Time
+	stale           bool
+}
+
+var _ component.LogsReceiver = (*slowLogReceiver)(nil)
+
+func newSlowLogReceiver(cfg *Config, settings component.ReceiverCreateSettings, consumer consumer.Logs) (*slowLogReceiver, error) {
+	switch cfg.Mode {
+	case modeCluster, modeSentinel:
+		opts, err := newRedisOptions(cfg)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.Mode == modeCluster {
+			d := &clusterDiscoverer{seed: newRedisClient(opts), endpoint: cfg.Endpoint}
+			return newDiscoverySlowLogReceiver(d, cfg.Cluster.RefreshInterval, newClientFactory(opts), cfg, settings, consumer), nil
+		}
+		d := &sentinelDiscoverer{sentinels: newSentinelClients(cfg, opts), masterNames: cfg.Sentinel.MasterNames}
+		return newDiscoverySlowLogReceiver(d, cfg.Sentinel.RefreshInterval, newClientFactory(opts), cfg, settings, consumer), nil
+	}
+
+	endpoints := cfg.Endpoints
+	if len(endpoints) == 0 {
+		endpoints = []EndpointSettings{{NetAddr: cfg.NetAddr}}
+	}
+	clients := make(map[string]client, len(endpoints))
+	for _, endpoint := range endpoints {
+		opts, err := newEndpointOptions(cfg, endpoint)
+		if err != nil {
+			return nil, err
+		}
+		clients[endpoint.Endpoint] = newRedisClient(opts)
+	}
+	return newSlowLogReceiverWithClients(clients, cfg, settings, consumer), nil
+}
+
+func newSlowLogReceiverWithClients(clients map[string]client, cfg *Config, settings component.ReceiverCreateSettings, consumer consumer.Logs) *slowLogReceiver {
+	pollers := make([]*slowLogPoller, 0, len(clients))
+	for endpoint, c := range clients {
+		pollers = append(pollers, newSlowLogPoller(endpoint, c, cfg.SlowLog.EmitBacklog))
+	}
+	sort.Slice(pollers, func(i, j int) bool { return pollers[i].endpoint < pollers[j].endpoint })
+	return &slowLogReceiver{
+		cfg:      cfg,
+		settings: settings,
+		consumer: consumer,
+		pollers:  pollers,
+	}
+}
+
+// newDiscoverySlowLogReceiver creates a receiver polling every server found by
+// the discoverer, re-running discovery as the discoveryScraper does.
+func newDiscoverySlowLogReceiver(
+	d discoverer,
+	refreshInterval time.Duration,
+	newClient clientFactory,
+	cfg *Config,
+	settings component.ReceiverCreateSettings,
+	consumer consumer.Logs,
+) *slowLogReceiver {
+	return &slowLogReceiver{
+		cfg:             cfg,
+		settings:        settings,
+		consumer:        consumer,
+		discoverer:      d,
+		refreshInterval: refreshInterval,
+		newClient:       newClient,
+	}
+}
+
+// Start begins polling in the background, starting right away.
+func (r *slowLogReceiver) Start(context.Context, component.Host) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(r.cfg.CollectionInterval)
+		defer ticker.Stop()
+		for {
+			r.poll(ctx)
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops polling and closes the connections to the servers.
+func (r *slowLogReceiver) Shutdown(context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+		<-r.done
+	}
+	var errs error
+	if r.discoverer != nil {
+		errs = r.discoverer.close()
+	}
+	for _, p := range r.pollers {
+		errs = multierr.Append(errs, p.client.close())
+	}
+	return errs
+}
+
+// refresh re-runs discovery, creating pollers for new servers and closing the
+// pollers of servers that are gone.
+func (r *slowLogReceiver) refresh() error {
+	known := make(map[string]client, len(r.pollers))
+	previous := make(map[string]*slowLogPoller, len(r.pollers))
+	for _, p := range r.pollers {
+		known[p.endpoint] = p.client
+		previous[p.endpoint] = p
+	}
+	nodes, err := r.discoverer.discover(known)
+	if err != nil {
+		return err
+	}
+
+	pollers := make([]*slowLogPoller, 0, len(nodes))
+	for _, node := range nodes {
+		p, ok := previous[node.addr]
+		if ok {
+			delete(previous, node.addr)
+		} else {
+			p = newSlowLogPoller(node.addr, r.newClient(node.addr), r.cfg.SlowLog.EmitBacklog)
+		}
+		p.resourceOptions = append([]metadata.ResourceOption{metadata.WithRedisEndpoint(node.addr)}, node.resourceOptions...)
+		pollers = append(pollers, p)
+	}
+	// Whatever is left was not discovered again.
+	for addr, p := range previous {
+		if err := p.client.close(); err != nil {
+			r.settings.Logger.Warn("failed to close node client", zap.String("addr", addr), zap.Error(err))
+		}
+	}
+	sort.Slice(pollers, func(i, j int) bool { return pollers[i].endpoint < pollers[j].endpoint })
+
+	r.pollers = pollers
+	r.lastRefresh = time.Now()
+	r.stale = false
+	return nil
+}
+
+// poll reads the new entries of every server and passes them on as logs.
+// Servers that fail are skipped until the next poll.
+func (r *slowLogReceiver) poll(ctx context.Context) {
+	ld := r.collect()
+	if ld.LogRecordCount() == 0 {
+		return
+	}
+	if err := r.consumer.ConsumeLogs(ctx, ld); err != nil {
+		r.settings.Logger.Warn("failed to consume slowlog entries", zap.Error(err))
+	}
+}
+
+// collect builds one ResourceLogs per server with new entries. In cluster and
+// sentinel modes, the servers are discovered again when it is due, or when a
+// server failed last time.
+func (r *slowLogReceiver) collect() pdata.Logs {
+	if r.discoverer != nil && (r.stale || time.Since(r.lastRefresh) >= r.refreshInterval) {
+		if err := r.refresh(); err != nil {
+			r.settings.Logger.Warn("failed to refresh discovered nodes, polling previously discovered nodes", zap.Error(err))
+		}
+	}
+
+	ld := pdata.NewLogs()
+	for _, p := range r.pollers {
+		entries, err := p.poll(r.cfg.SlowLog.MaxEntries)
+		if err != nil {
+			r.stale = true
+			if isAuthError(err) {
+				r.settings.Logger.Error("Redis server rejected the credentials", zap.String("endpoint", p.endpoint),
+					zap.Error(&authError{endpoint: p.endpoint, err: err}))
+			} else {
+				r.settings.Logger.Warn("failed to read slowlog", zap.String("endpoint", p.endpoint), zap.Error(err))
+			}
+			continue
+		}
+		if len(entries) == 0 {
+			continue
+		}
+
+		rl := ld.ResourceLogs().AppendEmpty()
+		r.cfg.ResourceAttributes.SetResourceAttributes(rl.Resource(), p.resourceOptions...)
+		ill := rl.InstrumentationLibraryLogs().AppendEmpty()
+		ill.InstrumentationLibrary().SetName("otelcol/redis")
+		for _, entry := range entries {
+			r.recordEntry(ill.LogRecords().AppendEmpty(), entry)
+		}
+	}
+	return ld
+}
+
+// recordEntry fills a log record from a SLOWLOG entry. The body is the command
+// line, with its arguments redacted unless configured otherwise.
+func (r *slowLogReceiver) recordEntry(lr pdata.LogRecord, entry *slowLogEntry) {
+	args := entry.args
+	if r.cfg.SlowLog.RedactArgs {
+		args = redactArgs(args)
+	}
+
+	lr.SetTimestamp(pdata.NewTimestampFromTime(entry.timestamp))
+	lr.Body().SetStringVal(strings.Join(args, " "))
+
+	attrs := lr.Attributes()
+	attrs.UpsertInt(slowLogIDAttr, entry.id)
+	attrs.UpsertInt(slowLogDurationAttr, entry.duration.Microseconds())
+	if len(args) > 0 {
+		attrs.UpsertString(slowLogCommandAttr, args[0])
+		argVals := pdata.NewAttributeValueArray()
+		for _, arg := range args[1:] {
+			argVals.SliceVal().AppendEmpty().SetStringVal(arg)
+		}
+		attrs.Upsert(slowLogArgsAttr, argVals)
+	}
+	if entry.clientAddr != "" {
+		attrs.UpsertString(slowLogClientAddrAttr, entry.clientAddr)
+	}
+	if entry.clientName != "" {
+		attrs.UpsertString(slowLogClientNameAttr, entry.clientName)
+	}
+}
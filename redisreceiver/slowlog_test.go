@@ -0,0 +1,262 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+)
+
+func TestParseSlowLog(t *testing.T) {
+	entries, err := parseSlowLog([]interface{}{
+		[]interface{}{int64(14), int64(1309448221), int64(15), []interface{}{"set", "user:1", "alice"}, "127.0.0.1:58217", "worker-1"},
+		// Redis before 4.0 does not report the client
+		[]interface{}{int64(13), int64(1309448128), int64(30), []interface{}{"ping"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, &slowLogEntry{
+		id:         14,
+		timestamp:  time.Unix(1309448221, 0),
+		duration:   15 * time.Microsecond,
+		args:       []string{"set", "user:1", "alice"},
+		clientAddr: "127.0.0.1:58217",
+		clientName: "worker-1",
+	}, entries[0])
+	assert.Equal(t, []string{"ping"}, entries[1].args)
+	assert.Empty(t, entries[1].clientAddr)
+
+	_, err = parseSlowLog([]interface{}{[]interface{}{"14", int64(1309448221), int64(15), []interface{}{"ping"}}})
+	require.Error(t, err)
+}
+
+func TestRedactArgs(t *testing.T) {
+	assert.Equal(t, []string{"set", "?", "?"}, redactArgs([]string{"set", "user:1", "alice"}))
+	assert.Equal(t, []string{"mset", "?", "?", "... (30 more arguments)"},
+		redactArgs([]string{"mset", "k1", "v1", "... (30 more arguments)"}))
+	assert.Empty(t, redactArgs(nil))
+}
+
+// fakeSlowLogClient serves a configurable SLOWLOG, newest entry first, and
+// the run_id of the server.
+type fakeSlowLogClient struct {
+	fakeClient
+	entries []*slowLogEntry
+	runID   string
+	err     error
+	closed  bool
+}
+
+func (c *fakeSlowLogClient) retrieveInfo([]string) (string, error) {
+	return "# Server" + c.delimiter() + "run_id:" + c.runID + c.delimiter(), c.err
+}
+
+func (c *fakeSlowLogClient) retrieveSlowLog(count int64) ([]*slowLogEntry, error) {
+	if int64(len(c.entries)) > count {
+		return c.entries[:count], c.err
+	}
+	return c.entries, c.err
+}
+
+func (c *fakeSlowLogClient) close() error {
+	c.closed = true
+	return nil
+}
+
+func (c *fakeSlowLogClient) add(id int64, args ...string) {
+	entry := &slowLogEntry{id: id, timestamp: time.Unix(1309448221+id, 0), duration: time.Millisecond, args: args}
+	c.entries = append([]*slowLogEntry{entry}, c.entries...)
+}
+
+func TestSlowLogPoller(t *testing.T) {
+	c := &fakeSlowLogClient{runID: "a"}
+	p := newSlowLogPoller("localhost:6379", c, false)
+
+	entries, err := p.poll(128)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	c.add(0, "keys", "*")
+	c.add(1, "hgetall", "big")
+	entries, err = p.poll(128)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	// oldest first
+	assert.Equal(t, int64(0), entries[0].id)
+	assert.Equal(t, int64(1), entries[1].id)
+
+	// nothing is emitted twice
+	entries, err = p.poll(128)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	c.add(2, "smembers", "big")
+	entries, err = p.poll(128)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, int64(2), entries[0].id)
+
+	// the server restarts, numbering entries from 0 again
+	c.runID = "b"
+	c.entries = nil
+	c.add(0, "flushall")
+	entries, err = p.poll(128)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, []string{"flushall"}, entries[0].args)
+
+	// the server restarts again and logs more entries than before by the
+	// next poll
+	c.runID = "c"
+	c.entries = nil
+	c.add(0, "keys", "*")
+	c.add(1, "hgetall", "big")
+	c.add(2, "smembers", "big")
+	entries, err = p.poll(128)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, int64(0), entries[0].id)
+
+	c.err = errors.New("connection refused")
+	_, err = p.poll(128)
+	require.Error(t, err)
+}
+
+func TestSlowLogPollerSkipsBacklog(t *testing.T) {
+	c := &fakeSlowLogClient{runID: "a"}
+	c.add(0, "keys", "*")
+	c.add(1, "hgetall", "big")
+
+	p := newSlowLogPoller("localhost:6379", c, false)
+	entries, err := p.poll(128)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+	c.add(2, "smembers", "big")
+	entries, err = p.poll(128)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, int64(2), entries[0].id)
+
+	p = newSlowLogPoller("localhost:6379", c, true)
+	entries, err = p.poll(128)
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+}
+
+func TestSlowLogReceiver(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SlowLog.EmitBacklog = true
+	a := &fakeSlowLogClient{}
+	a.add(7, "set", "user:1", "alice")
+	a.entries[0].clientAddr = "10.0.0.5:51234"
+	a.entries[0].clientName = "worker-1"
+	b := &fakeSlowLogClient{err: errors.New("connection refused")}
+	c := &fakeSlowLogClient{}
+
+	sink := new(consumertest.LogsSink)
+	r := newSlowLogReceiverWithClients(map[string]client{"redis-a:6379": a, "redis-b:6379": b, "redis-c:6379": c},
+		cfg, componenttest.NewNopReceiverCreateSettings(), sink)
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()))
+	require.Eventually(t, func() bool { return sink.LogRecordCount() > 0 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.True(t, a.closed)
+	assert.True(t, b.closed)
+	assert.True(t, c.closed)
+
+	ld := sink.AllLogs()[0]
+	// servers without new entries, or failing, are left out
+	require.Equal(t, 1, ld.ResourceLogs().Len())
+	rl := ld.ResourceLogs().At(0)
+	assert.Equal(t, map[string]interface{}{"redis.endpoint": "redis-a:6379"}, rl.Resource().Attributes().AsRaw())
+
+	lr := rl.InstrumentationLibraryLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, time.Unix(1309448228, 0).UTC(), lr.Timestamp().AsTime())
+	assert.Equal(t, "set ? ?", lr.Body().StringVal())
+	assert.Equal(t, map[string]interface{}{
+		slowLogIDAttr:         int64(7),
+		slowLogDurationAttr:   int64(1000),
+		slowLogCommandAttr:    "set",
+		slowLogArgsAttr:       []interface{}{"?", "?"},
+		slowLogClientAddrAttr: "10.0.0.5:51234",
+		slowLogClientNameAttr: "worker-1",
+	}, lr.Attributes().AsRaw())
+}
+
+func TestSlowLogReceiverWithoutRedaction(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.SlowLog.RedactArgs = false
+	cfg.SlowLog.EmitBacklog = true
+	c := &fakeSlowLogClient{}
+	c.add(0, "get", "user:1")
+	r := newSlowLogReceiverWithClients(map[string]client{"localhost:6379": c}, cfg, componenttest.NewNopReceiverCreateSettings(), consumertest.NewNop())
+
+	lr := r.collect().ResourceLogs().At(0).InstrumentationLibraryLogs().At(0).LogRecords().At(0)
+	assert.Equal(t, "get user:1", lr.Body().StringVal())
+	assert.Equal(t, []interface{}{"user:1"}, lr.Attributes().AsRaw()[slowLogArgsAttr])
+}
+
+func TestSlowLogReceiverClusterMode(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Endpoint = "127.0.0.1:30001"
+	cfg.Mode = modeCluster
+	cfg.Cluster.RefreshInterval = time.Hour
+	cfg.SlowLog.EmitBacklog = true
+
+	seed := &fakeClusterClient{nodes: "" +
+		"e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master - 0 0 1 connected 0-16383\n" +
+		"07c37dfeb235213a872192d90877d0cd55635b91 127.0.0.1:30004@31004 slave e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 0 1426238317239 4 connected\n"}
+	clients := map[string]*fakeSlowLogClient{}
+	factory := func(addr string) client {
+		clients[addr] = &fakeSlowLogClient{runID: addr}
+		clients[addr].add(0, "keys", "*")
+		return clients[addr]
+	}
+	d := &clusterDiscoverer{seed: seed, endpoint: cfg.Endpoint}
+	r := newDiscoverySlowLogReceiver(d, cfg.Cluster.RefreshInterval, factory, cfg, componenttest.NewNopReceiverCreateSettings(), consumertest.NewNop())
+
+	ld := r.collect()
+	require.Equal(t, 2, ld.ResourceLogs().Len())
+	assert.Equal(t, map[string]interface{}{
+		"redis.endpoint":             "127.0.0.1:30001",
+		"redis.cluster.node.id":      "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca",
+		"redis.cluster.node.address": "127.0.0.1:30001",
+		"redis.cluster.node.role":    "master",
+		"redis.cluster.node.slots":   "0-16383",
+	}, ld.ResourceLogs().At(0).Resource().Attributes().AsRaw())
+	assert.Equal(t, "slave", ld.ResourceLogs().At(1).Resource().Attributes().AsRaw()["redis.cluster.node.role"])
+
+	// the replica leaves the cluster, which is noticed as it fails
+	clients["127.0.0.1:30004"].err = errors.New("connection refused")
+	clients["127.0.0.1:30001"].add(1, "smembers", "big")
+	assert.Equal(t, 1, r.collect().LogRecordCount())
+	assert.True(t, r.stale)
+	seed.nodes = "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master - 0 0 1 connected 0-16383\n"
+	clients["127.0.0.1:30001"].add(2, "hgetall", "big")
+	ld = r.collect()
+	require.Equal(t, 1, ld.ResourceLogs().Len())
+	assert.Equal(t, 1, ld.LogRecordCount())
+	assert.True(t, clients["127.0.0.1:30004"].closed)
+
+	require.NoError(t, r.Shutdown(context.Background()))
+	assert.True(t, seed.closed)
+	assert.True(t, clients["127.0.0.1:30001"].closed)
+}
@@ -0,0 +1,244 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+// Holds a consumer group of a stream, from XINFO GROUPS.
+type streamGroup struct {
+	stream          string
+	name            string
+	pending         int64
+	lag             int64
+	hasLag          bool // false before Redis 7.0 or when the lag cannot be computed
+	lastDeliveredID string
+	consumers       []*streamConsumer
+}
+
+// Holds a consumer of a consumer group, from XINFO CONSUMERS.
+type streamConsumer struct {
+	name    string
+	pending int64
+	idle    time.Duration
+}
+
+// Turns a SCAN reply, the next cursor followed by the keys, into its parts.
+func parseScanReply(val interface{}) ([]string, uint64, error) {
+	vals, ok := val.([]interface{})
+	if !ok || len(vals) != 2 {
+		return nil, 0, fmt.Errorf("unexpected scan reply '%v'", val)
+	}
+	cursorStr, cursorOk := vals[0].(string)
+	keyVals, keysOk := vals[1].([]interface{})
+	if !cursorOk || !keysOk {
+		return nil, 0, fmt.Errorf("unexpected scan reply '%v'", val)
+	}
+	cursor, err := strconv.ParseUint(cursorStr, 10, 64)
+	if err != nil {
+		return nil, 0, err
+	}
+	keys := make([]string, 0, len(keyVals))
+	for _, keyVal := range keyVals {
+		key, ok := keyVal.(string)
+		if !ok {
+			return nil, 0, fmt.Errorf("unexpected scan key '%v'", keyVal)
+		}
+		keys = append(keys, key)
+	}
+	return keys, cursor, nil
+}
+
+// Turns an element of an XINFO reply, alternating field names and values,
+// into a map: e.g.
+// 1) "name"
+// 2) "workers"
+// 3) "pending"
+// 4) (integer) 2
+func parseStreamFields(val interface{}) (map[string]interface{}, error) {
+	vals, ok := val.([]interface{})
+	if !ok || len(vals)%2 != 0 {
+		return nil, fmt.Errorf("unexpected xinfo entry '%v'", val)
+	}
+	fields := make(map[string]interface{}, len(vals)/2)
+	for i := 0; i < len(vals); i += 2 {
+		name, ok := vals[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected xinfo field '%v'", vals[i])
+		}
+		fields[name] = vals[i+1]
+	}
+	return fields, nil
+}
+
+// Turns an XINFO GROUPS reply into the consumer groups of the stream. The lag
+// field is only reported by Redis 7.0 and later, and is nil when unknown.
+func parseStreamGroups(stream string, val interface{}) ([]*streamGroup, error) {
+	vals, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected xinfo groups reply '%v'", val)
+	}
+	groups := make([]*streamGroup, 0, len(vals))
+	for _, v := range vals {
+		fields, err := parseStreamFields(v)
+		if err != nil {
+			return nil, err
+		}
+		name, nameOk := fields["name"].(string)
+		pending, pendingOk := fields["pending"].(int64)
+		lastDeliveredID, idOk := fields["last-delivered-id"].(string)
+		if !nameOk || !pendingOk || !idOk {
+			return nil, fmt.Errorf("unexpected xinfo groups entry '%v'", v)
+		}
+		group := &streamGroup{
+			stream:          stream,
+			name:            name,
+			pending:         pending,
+			lastDeliveredID: lastDeliveredID,
+		}
+		group.lag, group.hasLag = fields["lag"].(int64)
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// Turns an XINFO CONSUMERS reply into the consumers of a group.
+func parseStreamConsumers(val interface{}) ([]*streamConsumer, error) {
+	vals, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected xinfo consumers reply '%v'", val)
+	}
+	consumers := make([]*streamConsumer, 0, len(vals))
+	for _, v := range vals {
+		fields, err := parseStreamFields(v)
+		if err != nil {
+			return nil, err
+		}
+		name, nameOk := fields["name"].(string)
+		pending, pendingOk := fields["pending"].(int64)
+		idle, idleOk := fields["idle"].(int64)
+		if !nameOk || !pendingOk || !idleOk {
+			return nil, fmt.Errorf("unexpected xinfo consumers entry '%v'", v)
+		}
+		consumers = append(consumers, &streamConsumer{
+			name:    name,
+			pending: pending,
+			idle:    time.Duration(idle) * time.Millisecond,
+		})
+	}
+	return consumers, nil
+}
+
+// streamIDTime returns the time a stream entry was added from its id, e.g.
+// "1526569495631-0". The id "0-0" of groups that were never delivered an
+// entry reports false.
+func streamIDTime(id string) (time.Time, bool) {
+	msStr := id
+	if i := strings.IndexByte(id, '-'); i >= 0 {
+		msStr = id[:i]
+	}
+	ms, err := strconv.ParseInt(msStr, 10, 64)
+	if err != nil || ms == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)), true
+}
+
+// recordStreamMetrics records the state of the consumer groups of the
+// configured streams and, if enabled, of the streams discovered in every
+// database of the keyspace, a batch of keys at a time across scrapes.
+func (rs *redisScraper) recordStreamMetrics(ts pdata.Timestamp, inf info) {
+	settings := rs.cfg.Streams
+	if len(settings.Keys) == 0 && !settings.Discovery {
+		return
+	}
+
+	streamsByDB := map[int][]string{}
+	seen := map[int]map[string]bool{}
+	add := func(db int, streams []string) {
+		if seen[db] == nil {
+			seen[db] = map[string]bool{}
+		}
+		for _, stream := range streams {
+			if !seen[db][stream] {
+				seen[db][stream] = true
+				streamsByDB[db] = append(streamsByDB[db], stream)
+			}
+		}
+	}
+	for _, key := range settings.Keys {
+		add(key.DB, []string{key.Key})
+	}
+	if settings.Discovery {
+		deadline := time.Now().Add(settings.TimeBudget)
+		scans := make(map[int]*keyScan, len(rs.streamScans))
+		for _, db := range inf.keyspaceDBs() {
+			scan, ok := rs.streamScans[db]
+			if !ok {
+				scan = &keyScan{}
+			}
+			scans[db] = scan
+			err := scan.advance(func(cursor uint64, count int64) ([]string, uint64, error) {
+				return rs.redisSvc.client.discoverStreams(db, cursor, count)
+			}, settings.MaxStreams, settings.KeysPerScrape, settings.ScanCount, deadline)
+			if err != nil {
+				// The streams found before are still reported.
+				rs.errs.AddPartial(1, fmt.Errorf("failed to discover streams of db %d: %w", db, err))
+			}
+			add(db, scan.keys())
+		}
+		// Databases that were emptied are forgotten.
+		rs.streamScans = scans
+	}
+
+	dbs := make([]int, 0, len(streamsByDB))
+	for db := range streamsByDB {
+		dbs = append(dbs, db)
+	}
+	sort.Ints(dbs)
+	now := ts.AsTime()
+	for _, db := range dbs {
+		groups, err := rs.redisSvc.client.retrieveStreamGroups(db, streamsByDB[db])
+		if err != nil {
+			rs.errs.AddPartial(len(streamsByDB[db]), fmt.Errorf("failed to retrieve stream consumer groups of db %d: %w", db, err))
+			continue
+		}
+		dbStr := strconv.Itoa(db)
+		for _, group := range groups {
+			rs.mb.RecordRedisStreamGroupPendingDataPoint(ts, group.pending, dbStr, group.stream, group.name)
+			if group.hasLag {
+				rs.mb.RecordRedisStreamGroupLagDataPoint(ts, group.lag, dbStr, group.stream, group.name)
+			}
+			if delivered, ok := streamIDTime(group.lastDeliveredID); ok {
+				age := now.Sub(delivered)
+				if age < 0 {
+					age = 0
+				}
+				rs.mb.RecordRedisStreamGroupLastDeliveredAgeDataPoint(ts, age.Milliseconds(), dbStr, group.stream, group.name)
+			}
+			for _, consumer := range group.consumers {
+				rs.mb.RecordRedisStreamConsumerPendingDataPoint(ts, consumer.pending, dbStr, group.stream, group.name, consumer.name)
+				rs.mb.RecordRedisStreamConsumerIdleDataPoint(ts, consumer.idle.Milliseconds(), dbStr, group.stream, group.name, consumer.name)
+			}
+		}
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/model/pdata"
+)
+
+func TestParseScanReply(t *testing.T) {
+	keys, cursor, err := parseScanReply([]interface{}{"17", []interface{}{"events", "orders"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"events", "orders"}, keys)
+	assert.Equal(t, uint64(17), cursor)
+
+	_, _, err = parseScanReply([]interface{}{"17"})
+	assert.Error(t, err)
+}
+
+func TestParseStreamGroups(t *testing.T) {
+	groups, err := parseStreamGroups("events", []interface{}{
+		// Redis 6.2
+		[]interface{}{"name", "billing", "consumers", int64(1), "pending", int64(4), "last-delivered-id", "1526569495631-0"},
+		// Redis 7.0, with an unknown lag
+		[]interface{}{"name", "audit", "consumers", int64(0), "pending", int64(0), "last-delivered-id", "0-0",
+			"entries-read", nil, "lag", nil},
+		// Redis 7.0
+		[]interface{}{"name", "search", "consumers", int64(2), "pending", int64(1), "last-delivered-id", "1526569495632-3",
+			"entries-read", int64(10), "lag", int64(5)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*streamGroup{
+		{stream: "events", name: "billing", pending: 4, lastDeliveredID: "1526569495631-0"},
+		{stream: "events", name: "audit", lastDeliveredID: "0-0"},
+		{stream: "events", name: "search", pending: 1, lag: 5, hasLag: true, lastDeliveredID: "1526569495632-3"},
+	}, groups)
+
+	_, err = parseStreamGroups("events", []interface{}{[]interface{}{"name", "billing", "pending"}})
+	assert.Error(t, err)
+	_, err = parseStreamGroups("events", []interface{}{[]interface{}{"name", "billing"}})
+	assert.Error(t, err)
+}
+
+func TestParseStreamConsumers(t *testing.T) {
+	consumers, err := parseStreamConsumers([]interface{}{
+		[]interface{}{"name", "worker-1", "pending", int64(3), "idle", int64(1500)},
+		// Redis 7.2
+		[]interface{}{"name", "worker-2", "pending", int64(0), "idle", int64(20), "inactive", int64(-1)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*streamConsumer{
+		{name: "worker-1", pending: 3, idle: 1500 * time.Millisecond},
+		{name: "worker-2", idle: 20 * time.Millisecond},
+	}, consumers)
+
+	_, err = parseStreamConsumers("worker-1")
+	assert.Error(t, err)
+}
+
+func TestStreamIDTime(t *testing.T) {
+	ts, ok := streamIDTime("1526569495631-0")
+	require.True(t, ok)
+	assert.Equal(t, int64(1526569495631), ts.UnixNano()/int64(time.Millisecond))
+
+	_, ok = streamIDTime("0-0")
+	assert.False(t, ok)
+	_, ok = streamIDTime("invalid")
+	assert.False(t, ok)
+}
+
+// streamsFakeClient holds the consumer groups of the streams per database.
+type streamsFakeClient struct {
+	fakeClient
+	groups     map[int][]*streamGroup
+	discovered []int
+}
+
+// discoverStreams scans the streams of a database in order, one key per
+// group, with the index of the next key as cursor.
+func (c *streamsFakeClient) discoverStreams(db int, cursor uint64, count int64) ([]string, uint64, error) {
+	c.discovered = append(c.discovered, db)
+	groups := c.groups[db]
+	end := int(cursor) + int(count)
+	next := uint64(end)
+	if end >= len(groups) {
+		end, next = len(groups), 0
+	}
+	var streams []string
+	for _, group := range groups[cursor:end] {
+		streams = append(streams, group.stream)
+	}
+	return streams, next, nil
+}
+
+func (c *streamsFakeClient) retrieveStreamGroups(db int, streams []string) ([]*streamGroup, error) {
+	var groups []*streamGroup
+	for _, stream := range streams {
+		for _, group := range c.groups[db] {
+			if group.stream == stream {
+				groups = append(groups, group)
+			}
+		}
+	}
+	return groups, nil
+}
+
+func newStreamsFakeClient(lastDeliveredID string) *streamsFakeClient {
+	return &streamsFakeClient{groups: map[int][]*streamGroup{
+		0: {
+			{stream: "events", name: "billing", pending: 4, lag: 2, hasLag: true, lastDeliveredID: lastDeliveredID,
+				consumers: []*streamConsumer{{name: "worker-1", pending: 4, idle: 1500 * time.Millisecond}}},
+			{stream: "orders", name: "shipping", lastDeliveredID: "0-0"},
+		},
+	}}
+}
+
+func streamDataPoints(t *testing.T, md pdata.Metrics, name string) map[string]int64 {
+	m, ok := findMetric(md, name)
+	require.True(t, ok, name)
+	values := map[string]int64{}
+	dps := m.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		attrs := dps.At(i).Attributes().AsRaw()
+		key := attrs["db"].(string) + "/" + attrs["stream"].(string) + "/" + attrs["group"].(string)
+		if consumer, ok := attrs["consumer"]; ok {
+			key += "/" + consumer.(string)
+		}
+		values[key] = dps.At(i).IntVal()
+	}
+	return values
+}
+
+func TestRedisScraperStreams(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Streams.Keys = []StreamKeySettings{{Key: "events"}, {Key: "orders"}}
+	delivered := time.Now().Add(-time.Minute)
+	client := newStreamsFakeClient(strconv.FormatInt(delivered.UnixNano()/int64(time.Millisecond), 10) + "-0")
+	runner, err := newRedisScraperWithClient(client, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, client.discovered)
+
+	assert.Equal(t, map[string]int64{"0/events/billing": 4, "0/orders/shipping": 0},
+		streamDataPoints(t, md, "redis.stream.group.pending"))
+	// the lag of groups the server cannot compute it for is not reported
+	assert.Equal(t, map[string]int64{"0/events/billing": 2},
+		streamDataPoints(t, md, "redis.stream.group.lag"))
+	// neither is the age of groups that were never delivered an entry
+	ages := streamDataPoints(t, md, "redis.stream.group.last_delivered_age")
+	require.Len(t, ages, 1)
+	assert.GreaterOrEqual(t, ages["0/events/billing"], time.Minute.Milliseconds())
+	assert.Equal(t, map[string]int64{"0/events/billing/worker-1": 4},
+		streamDataPoints(t, md, "redis.stream.consumer.pending"))
+	assert.Equal(t, map[string]int64{"0/events/billing/worker-1": 1500},
+		streamDataPoints(t, md, "redis.stream.consumer.idle"))
+}
+
+func TestRedisScraperStreamDiscovery(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Streams.Discovery = true
+	cfg.Streams.Keys = []StreamKeySettings{{Key: "events"}}
+	client := newStreamsFakeClient("0-0")
+	runner, err := newRedisScraperWithClient(client, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+
+	// only the databases of INFO keyspace are scanned
+	assert.Equal(t, []int{0, 1}, client.discovered)
+	// and configured streams are not reported twice
+	assert.Equal(t, map[string]int64{"0/events/billing": 4, "0/orders/shipping": 0},
+		streamDataPoints(t, md, "redis.stream.group.pending"))
+}
+
+func TestRedisScraperStreamDiscoveryBudget(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Streams.Discovery = true
+	cfg.Streams.KeysPerScrape = 1
+	client := newStreamsFakeClient("0-0")
+	runner, err := newRedisScraperWithClient(client, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+
+	// the streams found so far are reported until the first scan completes
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"0/events/billing": 4},
+		streamDataPoints(t, md, "redis.stream.group.pending"))
+
+	md, err = runner.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"0/events/billing": 4, "0/orders/shipping": 0},
+		streamDataPoints(t, md, "redis.stream.group.pending"))
+
+	// then those of the last complete scan while the next one is in progress
+	md, err = runner.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"0/events/billing": 4, "0/orders/shipping": 0},
+		streamDataPoints(t, md, "redis.stream.group.pending"))
+}
+
+func TestRedisScraperWithoutStreams(t *testing.T) {
+	client := newStreamsFakeClient("0-0")
+	runner, err := newRedisScraperWithClient(client, componenttest.NewNopReceiverCreateSettings(), createDefaultConfig().(*Config))
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, client.discovered)
+	_, ok := findMetric(md, "redis.stream.group.pending")
+	assert.False(t, ok)
+}
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/redisreceiver"
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/model/pdata"
+	"go.uber.org/zap"
+)
+
+// isGlobPattern reports whether a key contains unescaped glob-style special
+// characters, e.g. "jobs:*", and must be matched with SCAN.
+func isGlobPattern(key string) bool {
+	for i := 0; i < len(key); i++ {
+		switch key[i] {
+		case '\\':
+			i++
+		case '*', '?', '[':
+			return true
+		}
+	}
+	return false
+}
+
+// Identifies a watched glob-style pattern.
+type watchedPattern struct {
+	db      int
+	pattern string
+}
+
+// recordWatchedKeyMetrics records the length of each watched key, expanding
+// glob-style patterns into at most max_matches keys. Keys that do not exist,
+// e.g. a drained queue, are reported with a length of 0.
+func (rs *redisScraper) recordWatchedKeyMetrics(ts pdata.Timestamp) {
+	settings := rs.cfg.WatchedKeys
+	if len(settings.Keys) == 0 {
+		return
+	}
+
+	deadline := time.Now().Add(settings.TimeBudget)
+	keysByDB := map[int][]string{}
+	seen := map[int]map[string]bool{}
+	for _, watched := range settings.Keys {
+		keys := []string{watched.Key}
+		if isGlobPattern(watched.Key) {
+			p := watchedPattern{db: watched.DB, pattern: watched.Key}
+			scan, ok := rs.watchedKeyScans[p]
+			if !ok {
+				scan = &keyScan{}
+				if rs.watchedKeyScans == nil {
+					rs.watchedKeyScans = map[watchedPattern]*keyScan{}
+				}
+				rs.watchedKeyScans[p] = scan
+			}
+			err := scan.advance(func(cursor uint64, count int64) ([]string, uint64, error) {
+				return rs.redisSvc.client.matchKeys(watched.DB, watched.Key, cursor, count)
+			}, settings.MaxMatches, settings.KeysPerScrape, settings.ScanCount, deadline)
+			if err != nil {
+				// The keys found before are still reported.
+				rs.errs.AddPartial(1, fmt.Errorf("failed to match watched keys %s of db %d: %w", watched.Key, watched.DB, err))
+			}
+			keys = scan.keys()
+			if len(keys) == settings.MaxMatches {
+				rs.settings.Logger.Debug("watched key pattern reached max_matches", zap.String("pattern", watched.Key),
+					zap.Int("db", watched.DB))
+			}
+		}
+		if seen[watched.DB] == nil {
+			seen[watched.DB] = map[string]bool{}
+		}
+		for _, key := range keys {
+			if !seen[watched.DB][key] {
+				seen[watched.DB][key] = true
+				keysByDB[watched.DB] = append(keysByDB[watched.DB], key)
+			}
+		}
+	}
+
+	dbs := make([]int, 0, len(keysByDB))
+	for db := range keysByDB {
+		dbs = append(dbs, db)
+	}
+	sort.Ints(dbs)
+	for _, db := range dbs {
+		samples, err := rs.redisSvc.client.retrieveKeyLengths(db, keysByDB[db])
+		if err != nil {
+			rs.errs.AddPartial(len(keysByDB[db]), fmt.Errorf("failed to retrieve watched key lengths of db %d: %w", db, err))
+			continue
+		}
+		dbStr := strconv.Itoa(db)
+		for _, sample := range samples {
+			rs.mb.RecordRedisWatchedKeyLengthDataPoint(ts, sample.length, dbStr, sample.key, sample.keyType)
+		}
+	}
+}
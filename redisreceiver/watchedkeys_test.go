@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisreceiver
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func TestIsGlobPattern(t *testing.T) {
+	assert.True(t, isGlobPattern("jobs:*"))
+	assert.True(t, isGlobPattern("jobs:?"))
+	assert.True(t, isGlobPattern("jobs:[ab]"))
+	assert.False(t, isGlobPattern("jobs:pending"))
+	assert.False(t, isGlobPattern(`jobs:\*`))
+}
+
+// watchedKeysFakeClient holds typed keys per database, scanned in key order
+// with the index of the next key as cursor.
+type watchedKeysFakeClient struct {
+	fakeClient
+	dbs     map[int]map[string]*keySample
+	scanned int
+}
+
+func (c *watchedKeysFakeClient) matchKeys(db int, pattern string, cursor uint64, count int64) ([]string, uint64, error) {
+	expr, err := globToRegexp(pattern)
+	if err != nil {
+		return nil, 0, err
+	}
+	re := regexp.MustCompile(expr)
+	keys := make([]string, 0, len(c.dbs[db]))
+	for key := range c.dbs[db] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	end := int(cursor) + int(count)
+	next := uint64(end)
+	if end >= len(keys) {
+		end, next = len(keys), 0
+	}
+	var matches []string
+	for _, key := range keys[cursor:end] {
+		c.scanned++
+		if re.MatchString(key) {
+			matches = append(matches, key)
+		}
+	}
+	return matches, next, nil
+}
+
+func (c *watchedKeysFakeClient) retrieveKeyLengths(db int, keys []string) ([]*keySample, error) {
+	samples := make([]*keySample, 0, len(keys))
+	for _, key := range keys {
+		if sample, ok := c.dbs[db][key]; ok {
+			samples = append(samples, sample)
+		} else {
+			samples = append(samples, &keySample{key: key, keyType: "none"})
+		}
+	}
+	return samples, nil
+}
+
+func TestRedisScraperWatchedKeys(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.WatchedKeys.MaxMatches = 2
+	cfg.WatchedKeys.Keys = []WatchedKeySettings{
+		{Key: "jobs:*"},
+		{Key: "jobs:email"},
+		{Key: "jobs:drained"},
+		{Key: "events", DB: 1},
+	}
+	client := &watchedKeysFakeClient{dbs: map[int]map[string]*keySample{
+		0: {
+			"jobs:email":   {key: "jobs:email", keyType: "list", length: 12},
+			"jobs:reports": {key: "jobs:reports", keyType: "zset", length: 3},
+			"jobs:sms":     {key: "jobs:sms", keyType: "list", length: 1},
+		},
+		1: {
+			"events": {key: "events", keyType: "stream", length: 1000},
+		},
+	}}
+	runner, err := newRedisScraperWithClient(client, componenttest.NewNopReceiverCreateSettings(), cfg)
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+
+	m, ok := findMetric(md, "redis.watched_key.length")
+	require.True(t, ok)
+	lengths := map[string]int64{}
+	types := map[string]interface{}{}
+	dps := m.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		attrs := dps.At(i).Attributes().AsRaw()
+		key := attrs["db"].(string) + "/" + attrs["key"].(string)
+		lengths[key] = dps.At(i).IntVal()
+		types[key] = attrs["type"]
+	}
+	// the pattern is limited to max_matches keys, and keys are reported once
+	assert.Equal(t, map[string]int64{
+		"0/jobs:email":   12,
+		"0/jobs:reports": 3,
+		"0/jobs:drained": 0,
+		"1/events":       1000,
+	}, lengths)
+	assert.Equal(t, "none", types["0/jobs:drained"])
+	assert.Equal(t, "stream", types["1/events"])
+}
+
+func TestRedisScraperWithoutWatchedKeys(t *testing.T) {
+	runner, err := newRedisScraperWithClient(newFakeClient(), componenttest.NewNopReceiverCreateSettings(), createDefaultConfig().(*Config))
+	require.NoError(t, err)
+	md, err := runner.Scrape(context.Background())
+	require.NoError(t, err)
+	_, ok := findMetric(md, "redis.watched_key.length")
+	assert.False(t, ok)
+}